@@ -1,6 +1,9 @@
 package goval
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type ruleCode int
 
@@ -36,6 +39,112 @@ const (
 	StringMatch
 	StringIn
 	StringInFold
+	StringRuneMin
+	StringRuneMax
+	StringGraphemeMin
+	StringGraphemeMax
+	StringDisplayWidthMax
+	StringIP
+	StringIPv4
+	StringIPv6
+	StringCIDR
+	StringIPInPrefix
+	StringHostPort
+	StringMAC
+	StringPublicIP
+	StringURL
+	StringURLAbsolute
+	StringURLScheme
+	StringURLHost
+	StringURLUserinfo
+	StringURLIPHost
+	StringURLPrivateHost
+	StringURLHostNotAllowed
+	StringURLHostDenied
+	StringURLMaxLength
+	StringEmail
+	StringEmailDisplayName
+	StringEmailLocalLength
+	StringEmailDomainLength
+	StringEmailTLD
+	StringEmailDomainNotAllowed
+	StringEmailDomainDenied
+	StringEmailDisposable
+	StringContains
+	StringContainsFold
+	StringContainsAny
+	StringContainsAnyFold
+	StringExcludes
+	StringExcludesFold
+	StringExcludesAny
+	StringExcludesAnyFold
+	StringHasPrefix
+	StringHasPrefixFold
+	StringHasSuffix
+	StringHasSuffixFold
+	StringPasswordMin
+	StringPasswordLower
+	StringPasswordUpper
+	StringPasswordDigit
+	StringPasswordSymbol
+	StringPasswordRepeat
+	StringPasswordSequence
+	StringPasswordEntropy
+	StringPasswordIdentity
+	StringPasswordCommon
+	StringJSON
+	StringBase64
+	StringHex
+	StringCreditCard
+	StringCreditCardChecksum
+	StringCreditCardBrand
+	StringIBAN
+	StringIBANCountry
+	StringIBANLength
+	StringIBANChecksum
+	StringISBN
+	StringISBN10
+	StringISBN13
+	StringEAN8
+	StringEAN13
+	StringUPCA
+	StringISIN
+	StringFilename
+	StringFilenameLength
+	StringFilenameSeparator
+	StringFilenameCharacter
+	StringFilenameReserved
+	StringRelativePath
+	StringPathTraversal
+	StringExtensionIn
+	StringExistsIn
+	StringMediaType
+	StringMediaTypeVendor
+	StringMediaTypeNotAllowed
+	StringUUID
+	StringUUIDCase
+	StringUUIDNil
+	StringUUIDVersion
+	StringUUIDVariant
+	StringULID
+	StringRegexp
+	StringRegexpSize
+	StringTemplate
+	StringJSONPointer
+	StringGlob
+	StringLowercase
+	StringUppercase
+	StringSlug
+	StringSnakeCase
+	StringScreamingSnake
+	StringCamelCase
+	StringKebabCase
+	StringTrimmed
+	StringSingleLine
+	StringNoControlChars
+	StringSafeTextCharacter
+	StringSafeTextBidi
+	StringSafeTextMixedScript
 )
 
 const (
@@ -62,3 +171,146 @@ const (
 	TimeMin
 	TimeMax
 )
+
+func init() {
+	MustRegisterRuleCode(
+		builtinRuleCode(PtrRequired, "pointers.required"),
+		builtinRuleCode(StringRequired, "strings.required"),
+		builtinRuleCode(StringMin, "strings.min", "min"),
+		builtinRuleCode(StringMax, "strings.max", "max"),
+		builtinRuleCode(StringMatch, "strings.match", "pattern"),
+		builtinRuleCode(StringIn, "strings.in", "options"),
+		builtinRuleCode(StringInFold, "strings.in_fold", "options"),
+		builtinRuleCode(StringRuneMin, "strings.rune_min", "min"),
+		builtinRuleCode(StringRuneMax, "strings.rune_max", "max"),
+		builtinRuleCode(StringGraphemeMin, "strings.grapheme_min", "min"),
+		builtinRuleCode(StringGraphemeMax, "strings.grapheme_max", "max"),
+		builtinRuleCode(StringDisplayWidthMax, "strings.display_width_max", "max"),
+		builtinRuleCode(StringIP, "strings.ip"),
+		builtinRuleCode(StringIPv4, "strings.ipv4"),
+		builtinRuleCode(StringIPv6, "strings.ipv6"),
+		builtinRuleCode(StringCIDR, "strings.cidr"),
+		builtinRuleCode(StringIPInPrefix, "strings.ip_in_prefix", "prefixes"),
+		builtinRuleCode(StringHostPort, "strings.host_port"),
+		builtinRuleCode(StringMAC, "strings.mac"),
+		builtinRuleCode(StringPublicIP, "strings.public_ip"),
+		builtinRuleCode(StringURL, "strings.url"),
+		builtinRuleCode(StringURLAbsolute, "strings.url_absolute"),
+		builtinRuleCode(StringURLScheme, "strings.url_scheme", "scheme", "schemes"),
+		builtinRuleCode(StringURLHost, "strings.url_host"),
+		builtinRuleCode(StringURLUserinfo, "strings.url_userinfo"),
+		builtinRuleCode(StringURLIPHost, "strings.url_ip_host", "host"),
+		builtinRuleCode(StringURLPrivateHost, "strings.url_private_host", "host"),
+		builtinRuleCode(StringURLHostNotAllowed, "strings.url_host_not_allowed", "host"),
+		builtinRuleCode(StringURLHostDenied, "strings.url_host_denied", "host"),
+		builtinRuleCode(StringURLMaxLength, "strings.url_max_length", "max"),
+		builtinRuleCode(StringEmail, "strings.email"),
+		builtinRuleCode(StringEmailDisplayName, "strings.email_display_name"),
+		builtinRuleCode(StringEmailLocalLength, "strings.email_local_length", "max"),
+		builtinRuleCode(StringEmailDomainLength, "strings.email_domain_length", "max"),
+		builtinRuleCode(StringEmailTLD, "strings.email_tld"),
+		builtinRuleCode(StringEmailDomainNotAllowed, "strings.email_domain_not_allowed", "domain"),
+		builtinRuleCode(StringEmailDomainDenied, "strings.email_domain_denied", "domain"),
+		builtinRuleCode(StringEmailDisposable, "strings.email_disposable", "domain"),
+		builtinRuleCode(StringContains, "strings.contains", "substr"),
+		builtinRuleCode(StringContainsFold, "strings.contains_fold", "substr"),
+		builtinRuleCode(StringContainsAny, "strings.contains_any", "substrs"),
+		builtinRuleCode(StringContainsAnyFold, "strings.contains_any_fold", "substrs"),
+		builtinRuleCode(StringExcludes, "strings.excludes", "substr"),
+		builtinRuleCode(StringExcludesFold, "strings.excludes_fold", "substr"),
+		builtinRuleCode(StringExcludesAny, "strings.excludes_any", "substr"),
+		builtinRuleCode(StringExcludesAnyFold, "strings.excludes_any_fold", "substr"),
+		builtinRuleCode(StringHasPrefix, "strings.has_prefix", "prefix"),
+		builtinRuleCode(StringHasPrefixFold, "strings.has_prefix_fold", "prefix"),
+		builtinRuleCode(StringHasSuffix, "strings.has_suffix", "suffix"),
+		builtinRuleCode(StringHasSuffixFold, "strings.has_suffix_fold", "suffix"),
+		builtinRuleCode(StringPasswordMin, "strings.password_min", "min"),
+		builtinRuleCode(StringPasswordLower, "strings.password_lower"),
+		builtinRuleCode(StringPasswordUpper, "strings.password_upper"),
+		builtinRuleCode(StringPasswordDigit, "strings.password_digit"),
+		builtinRuleCode(StringPasswordSymbol, "strings.password_symbol"),
+		builtinRuleCode(StringPasswordRepeat, "strings.password_repeat", "max"),
+		builtinRuleCode(StringPasswordSequence, "strings.password_sequence", "max"),
+		builtinRuleCode(StringPasswordEntropy, "strings.password_entropy", "min"),
+		builtinRuleCode(StringPasswordIdentity, "strings.password_identity"),
+		builtinRuleCode(StringPasswordCommon, "strings.password_common"),
+		builtinRuleCode(StringJSON, "strings.json", "offset"),
+		builtinRuleCode(StringBase64, "strings.base64", "offset"),
+		builtinRuleCode(StringHex, "strings.hex", "offset"),
+		builtinRuleCode(StringCreditCard, "strings.credit_card"),
+		builtinRuleCode(StringCreditCardChecksum, "strings.credit_card_checksum", "brand"),
+		builtinRuleCode(StringCreditCardBrand, "strings.credit_card_brand", "brand", "brands"),
+		builtinRuleCode(StringIBAN, "strings.iban"),
+		builtinRuleCode(StringIBANCountry, "strings.iban_country", "country"),
+		builtinRuleCode(StringIBANLength, "strings.iban_length", "country", "length"),
+		builtinRuleCode(StringIBANChecksum, "strings.iban_checksum", "country"),
+		builtinRuleCode(StringISBN, "strings.isbn"),
+		builtinRuleCode(StringISBN10, "strings.isbn10"),
+		builtinRuleCode(StringISBN13, "strings.isbn13"),
+		builtinRuleCode(StringEAN8, "strings.ean8"),
+		builtinRuleCode(StringEAN13, "strings.ean13"),
+		builtinRuleCode(StringUPCA, "strings.upca"),
+		builtinRuleCode(StringISIN, "strings.isin"),
+		builtinRuleCode(StringFilename, "strings.filename"),
+		builtinRuleCode(StringFilenameLength, "strings.filename_length", "max"),
+		builtinRuleCode(StringFilenameSeparator, "strings.filename_separator"),
+		builtinRuleCode(StringFilenameCharacter, "strings.filename_character", "char"),
+		builtinRuleCode(StringFilenameReserved, "strings.filename_reserved", "name"),
+		builtinRuleCode(StringRelativePath, "strings.relative_path"),
+		builtinRuleCode(StringPathTraversal, "strings.path_traversal"),
+		builtinRuleCode(StringExtensionIn, "strings.extension_in", "extensions"),
+		builtinRuleCode(StringExistsIn, "strings.exists_in"),
+		builtinRuleCode(StringMediaType, "strings.media_type"),
+		builtinRuleCode(StringMediaTypeVendor, "strings.media_type_vendor", "type"),
+		builtinRuleCode(StringMediaTypeNotAllowed, "strings.media_type_not_allowed", "type", "params", "allowed"),
+		builtinRuleCode(StringUUID, "strings.uuid"),
+		builtinRuleCode(StringUUIDCase, "strings.uuid_case"),
+		builtinRuleCode(StringUUIDNil, "strings.uuid_nil"),
+		builtinRuleCode(StringUUIDVersion, "strings.uuid_version", "version", "versions"),
+		builtinRuleCode(StringUUIDVariant, "strings.uuid_variant"),
+		builtinRuleCode(StringULID, "strings.ulid"),
+		builtinRuleCode(StringRegexp, "strings.regexp", "offset", "reason"),
+		builtinRuleCode(StringRegexpSize, "strings.regexp_size", "max"),
+		builtinRuleCode(StringTemplate, "strings.template", "line", "reason"),
+		builtinRuleCode(StringJSONPointer, "strings.json_pointer", "offset"),
+		builtinRuleCode(StringGlob, "strings.glob", "offset"),
+		builtinRuleCode(StringLowercase, "strings.lowercase"),
+		builtinRuleCode(StringUppercase, "strings.uppercase"),
+		builtinRuleCode(StringSlug, "strings.slug"),
+		builtinRuleCode(StringSnakeCase, "strings.snake_case"),
+		builtinRuleCode(StringScreamingSnake, "strings.screaming_snake"),
+		builtinRuleCode(StringCamelCase, "strings.camel_case"),
+		builtinRuleCode(StringKebabCase, "strings.kebab_case"),
+		builtinRuleCode(StringTrimmed, "strings.trimmed"),
+		builtinRuleCode(StringSingleLine, "strings.single_line", "offset"),
+		builtinRuleCode(StringNoControlChars, "strings.no_control_chars", "offset"),
+		builtinRuleCode(StringSafeTextCharacter, "strings.safe_text_character", "rune", "offset"),
+		builtinRuleCode(StringSafeTextBidi, "strings.safe_text_bidi", "rune", "offset"),
+		builtinRuleCode(StringSafeTextMixedScript, "strings.safe_text_mixed_script", "rune", "offset", "script"),
+		builtinRuleCode(NumberRequired, "numbers.required"),
+		builtinRuleCode(NumberMin, "numbers.min", "min"),
+		builtinRuleCode(NumberMax, "numbers.max", "max"),
+		builtinRuleCode(NumberIn, "numbers.in", "options"),
+		builtinRuleCode(SliceRequired, "slices.required"),
+		builtinRuleCode(SliceMin, "slices.min", "min"),
+		builtinRuleCode(SliceMax, "slices.max", "max"),
+		builtinRuleCode(MapRequired, "maps.required"),
+		builtinRuleCode(MapMin, "maps.min", "min"),
+		builtinRuleCode(MapMax, "maps.max", "max"),
+		builtinRuleCode(TimeRequired, "times.required"),
+		builtinRuleCode(TimeMin, "times.min", "min"),
+		builtinRuleCode(TimeMax, "times.max", "max"),
+	)
+}
+
+// builtinRuleCode describes a rule code of this package, the namespace is taken from the ID.
+func builtinRuleCode(code ruleCode, id string, argNames ...string) RuleCodeInfo {
+	namespace, _, _ := strings.Cut(id, ".")
+	return RuleCodeInfo{
+		Code:      code,
+		Namespace: namespace,
+		ID:        id,
+		Arity:     len(argNames),
+		ArgNames:  argNames,
+	}
+}
@@ -1,9 +1,12 @@
 package goval
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 )
 
@@ -14,18 +17,33 @@ type jsonErrorStringer interface {
 	fmt.Stringer
 }
 
+// jsonErrorTree is an interface for the error types that can be restored from their JSON representation.
+type jsonErrorTree interface {
+	jsonErrorStringer
+	json.Unmarshaler
+}
+
 // auxRuleError is an auxiliary type for marshaling RuleError.
-// It is used to avoid infinite recursion when marshaling RuleError.
-type auxRuleError RuleError
+// It is used to avoid infinite recursion when marshaling RuleError,
+// and to write the code according to the active RuleCodeFormat.
+type auxRuleError struct {
+	Code   any            `json:"code"`
+	Args   []any          `json:"args,omitempty"`
+	Params map[string]any `json:"params,omitempty"`
+}
 
 // RuleError is an error type for validation errors.
+//
+// The Params are set when the error is decoded from JSON. For a new error, they are left nil and resolved
+// from the Args on demand by NamedArgs, so creating an error does not look up the registered codes.
 type RuleError struct {
-	Code RuleCoder `json:"code"`           // the error code that identifies which rule failed.
-	Args []any     `json:"args,omitempty"` // additional arguments for the error.
+	Code   RuleCoder      `json:"code"`             // the error code that identifies which rule failed.
+	Args   []any          `json:"args,omitempty"`   // additional arguments for the error.
+	Params map[string]any `json:"params,omitempty"` // the args by their registered names, e.g. {"min": 2}.
 }
 
-// ensure RuleError implements jsonErrorStringer.
-var _ jsonErrorStringer = (*RuleError)(nil)
+// ensure RuleError implements jsonErrorTree.
+var _ jsonErrorTree = (*RuleError)(nil)
 
 // NewRuleError creates a new RuleError.
 func NewRuleError(code RuleCoder, args ...any) *RuleError {
@@ -35,9 +53,79 @@ func NewRuleError(code RuleCoder, args ...any) *RuleError {
 	}
 }
 
-func (r *RuleError) Error() string                { return r.String() }
-func (r *RuleError) String() string               { return stringifyJSON(r) }
-func (r *RuleError) MarshalJSON() ([]byte, error) { return json.Marshal(auxRuleError(*r)) }
+// NamedArgs returns the Params, or the args by the registered arg names of the code if the Params are not set.
+// It returns nil if the code is not registered or has no args.
+func (r *RuleError) NamedArgs() map[string]any {
+	if r.Params != nil {
+		return r.Params
+	}
+	return paramsOf(r.Code, r.Args)
+}
+
+// paramsOf maps the args by the registered arg names of the code.
+// It returns nil if the code is not registered or has no args.
+func paramsOf(code RuleCoder, args []any) map[string]any {
+	if code == nil || len(args) == 0 {
+		return nil
+	}
+
+	info, ok := LookupRuleCode(code)
+	if !ok || len(info.ArgNames) == 0 {
+		return nil
+	}
+
+	params := make(map[string]any, len(info.ArgNames))
+	for i, name := range info.ArgNames {
+		if i < len(args) {
+			params[name] = args[i]
+		}
+	}
+	return params
+}
+
+func (r *RuleError) Error() string                 { return r.String() }
+func (r *RuleError) String() string                { return stringifyJSON(r) }
+func (r *RuleError) Format(s fmt.State, verb rune) { formatError(s, verb, r) }
+func (r *RuleError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(auxRuleError{Code: marshalRuleCode(r.Code), Args: r.Args, Params: r.NamedArgs()})
+}
+
+// Is reports whether the target is a RuleError with the same code, the args are not compared.
+// It allows matching the rule code using errors.Is, for example:
+//
+//	errors.Is(err, goval.NewRuleError(goval.StringMin))
+func (r *RuleError) Is(target error) bool {
+	t, ok := target.(*RuleError)
+	return ok && t.Code != nil && r.Code != nil && r.Code.Equal(t.Code)
+}
+
+// UnmarshalJSON restores the RuleError from its JSON representation.
+// The code, either numeric or string ID, is resolved by DecodeRuleCode, and the args and the params are decoded
+// as the generic JSON values, for example, a number will be a float64.
+func (r *RuleError) UnmarshalJSON(b []byte) error {
+	var aux struct {
+		Code   json.RawMessage `json:"code"`
+		Args   []any           `json:"args"`
+		Params map[string]any  `json:"params"`
+	}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+
+	if len(aux.Code) == 0 {
+		return errors.New("goval: rule error has no code")
+	}
+
+	code, err := DecodeRuleCode(aux.Code)
+	if err != nil {
+		return err
+	}
+
+	r.Code = code
+	r.Args = aux.Args
+	r.Params = aux.Params
+	return nil
+}
 
 // TextError is an error type for turning an ordinary string to an error.
 // This error type is intended to be used for creating an error that can be marshaled to JSON.
@@ -86,8 +174,8 @@ type KeyError struct {
 // auxKeyError is an auxiliary type for marshaling KeyError.
 type auxKeyError KeyError
 
-// ensure KeyError implements jsonErrorStringer.
-var _ jsonErrorStringer = (*KeyError)(nil)
+// ensure KeyError implements jsonErrorTree.
+var _ jsonErrorTree = (*KeyError)(nil)
 
 // NewKeyError creates a new KeyError.
 func NewKeyError(key string, err error) *KeyError {
@@ -97,8 +185,10 @@ func NewKeyError(key string, err error) *KeyError {
 	}
 }
 
-func (k *KeyError) Error() string  { return k.String() }
-func (k *KeyError) String() string { return stringifyJSON(k) }
+func (k *KeyError) Error() string                 { return k.String() }
+func (k *KeyError) String() string                { return stringifyJSON(k) }
+func (k *KeyError) Unwrap() error                 { return k.Err }
+func (k *KeyError) Format(s fmt.State, verb rune) { formatError(s, verb, k) }
 func (k *KeyError) MarshalJSON() ([]byte, error) {
 	// if the error is not a json.Marshaler, we convert it to a TextError.
 	if _, ok := k.Err.(json.Marshaler); !ok {
@@ -109,15 +199,62 @@ func (k *KeyError) MarshalJSON() ([]byte, error) {
 	return json.Marshal(aux)
 }
 
+// UnmarshalJSON restores the KeyError and its nested error from their JSON representation.
+func (k *KeyError) UnmarshalJSON(b []byte) error {
+	var aux struct {
+		Key string          `json:"key"`
+		Err json.RawMessage `json:"err"`
+	}
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+
+	err, e := unmarshalError(aux.Err)
+	if e != nil {
+		return fmt.Errorf("goval: key %q: %w", aux.Key, e)
+	}
+
+	k.Key = aux.Key
+	k.Err = err
+	return nil
+}
+
 // Errors is a type for collecting multiple errors and bundling them into a single error.
 type Errors []error
 
-// ensure Errors implements jsonErrorStringer.
-var _ jsonErrorStringer = make(Errors, 0)
+// ensure Errors implements jsonErrorTree.
+var _ jsonErrorTree = new(Errors)
+
+func (e Errors) Error() string                 { return e.String() }
+func (e Errors) String() string                { return stringifyJSON(e) }
+func (e Errors) Format(s fmt.State, verb rune) { formatError(s, verb, e) }
+func (e Errors) MarshalJSON() ([]byte, error)  { return json.Marshal([]error(e)) }
+
+// UnmarshalJSON restores each of the errors from their JSON representation.
+func (e *Errors) UnmarshalJSON(b []byte) error {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(b, &raws); err != nil {
+		return err
+	}
+
+	if raws == nil {
+		*e = nil
+		return nil
+	}
+
+	errs := make(Errors, 0, len(raws))
+	for _, raw := range raws {
+		err, e := unmarshalError(raw)
+		if e != nil {
+			return e
+		}
+		errs = append(errs, err)
+	}
+
+	*e = errs
+	return nil
+}
 
-func (e Errors) Error() string                { return e.String() }
-func (e Errors) String() string               { return stringifyJSON(e) }
-func (e Errors) MarshalJSON() ([]byte, error) { return json.Marshal([]error(e)) }
 func (e Errors) NilIfEmpty() error {
 	if len(e) > 0 {
 		return e
@@ -135,10 +272,92 @@ func stringifyJSON(m json.Marshaler) string {
 	return string(b)
 }
 
+// DecodedError is an error tree restored from its JSON representation, see UnmarshalError.
+// It implements json.Unmarshaler, so it can also be a field of a decoded response.
+type DecodedError struct {
+	// Err is one of *RuleError, *KeyError, Errors or TextError, nil if the JSON value is null.
+	Err error
+}
+
+// UnmarshalJSON restores the error tree, see UnmarshalError.
+func (d *DecodedError) UnmarshalJSON(b []byte) error {
+	err, decodeErr := unmarshalError(b)
+	if decodeErr != nil {
+		return decodeErr
+	}
+
+	d.Err = err
+	return nil
+}
+
+// UnmarshalError restores an error tree produced by the validators from its JSON representation.
+// The restored error is one of *RuleError, *KeyError, Errors or TextError, so the rule codes can be
+// compared with errors.As and RuleCoder.Equal after a network round-trip.
+func UnmarshalError(b []byte) (DecodedError, error) {
+	var d DecodedError
+	if err := d.UnmarshalJSON(b); err != nil {
+		return DecodedError{}, err
+	}
+	return d, nil
+}
+
+// unmarshalError detects the error type from the shape of the JSON value and decodes it.
+// A JSON string is a TextError, an array is Errors, an object with a key is KeyError,
+// and any other object is RuleError.
+func unmarshalError(raw json.RawMessage) (error, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil, nil
+	}
+
+	switch raw[0] {
+	case '"':
+		var text string
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return nil, err
+		}
+		return TextError(text), nil
+	case '[':
+		var errs Errors
+		if err := errs.UnmarshalJSON(raw); err != nil {
+			return nil, err
+		}
+		return errs, nil
+	case '{':
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return nil, err
+		}
+
+		if _, ok := fields["key"]; ok {
+			var keyErr KeyError
+			if err := keyErr.UnmarshalJSON(raw); err != nil {
+				return nil, err
+			}
+			return &keyErr, nil
+		}
+
+		var ruleErr RuleError
+		if err := ruleErr.UnmarshalJSON(raw); err != nil {
+			return nil, err
+		}
+		return &ruleErr, nil
+	default:
+		return nil, fmt.Errorf("goval: unexpected JSON error value %s", raw)
+	}
+}
+
 // InternalError is an error wrapper to indicate an internal error.
 // If goval got this type of error, it will not include in Errors and KeyError.
+//
+// A panic in a rule or a validator is recovered by Chain, Named and Execute into an InternalError,
+// with the recovered value, the stack trace, the path of the keys and the rule where it happened.
 type InternalError struct {
-	Err error
+	Err       error
+	Recovered any      // the value recovered from the panic, nil if the error is not caused by a panic.
+	Stack     []byte   // the stack trace of the panic.
+	Path      []string // the keys of the Named validators, from the outermost to the innermost.
+	Rule      string   // the function name of the rule that panicked, e.g. "goval.SVV[...].Min.func1".
 }
 
 // NewInternalError creates a new InternalError.
@@ -146,6 +365,25 @@ func NewInternalError(err error) *InternalError {
 	return &InternalError{Err: err}
 }
 
-func (e *InternalError) Error() string  { return e.String() }
-func (e *InternalError) Unwrap() error  { return e.Err }
-func (e *InternalError) String() string { return "goval.InternalError: " + e.Err.Error() }
+func (e *InternalError) Error() string { return e.String() }
+func (e *InternalError) Unwrap() error { return e.Err }
+func (e *InternalError) String() string {
+	var b strings.Builder
+	b.WriteString("goval.InternalError: ")
+	if len(e.Path) > 0 {
+		b.WriteString(strings.Join(e.Path, ".") + ": ")
+	}
+	if e.Rule != "" {
+		b.WriteString("rule " + e.Rule + ": ")
+	}
+	b.WriteString(e.Err.Error())
+	return b.String()
+}
+
+// withKey returns a copy of the error with the key prepended to the path.
+// The copy keeps the error that is returned by a validator untouched when it is reused.
+func (e *InternalError) withKey(key string) *InternalError {
+	cp := *e
+	cp.Path = append([]string{key}, e.Path...)
+	return &cp
+}
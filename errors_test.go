@@ -1,20 +1,80 @@
 package goval_test
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/pkg-id/goval"
+	"reflect"
 	"testing"
 )
 
 func TestRuleError(t *testing.T) {
 	err := goval.NewRuleError(goval.NumberMin, 3)
-	exp := `{"code":3001,"args":[3]}`
+	exp := `{"code":3001,"args":[3],"params":{"min":3}}`
 	got := err.Error()
 	if got != exp {
 		t.Errorf("expect string Error: %q; got %q", exp, got)
 	}
 }
 
+func TestRuleError_NamedArgs(t *testing.T) {
+	err := goval.NewRuleError(goval.StringIn, []string{"a", "b"})
+	if err.Params != nil {
+		t.Errorf("expect the params are not resolved by NewRuleError; got %v", err.Params)
+	}
+
+	exp := map[string]any{"options": []string{"a", "b"}}
+	if !reflect.DeepEqual(err.NamedArgs(), exp) {
+		t.Errorf("expect params: %v; got %v", exp, err.NamedArgs())
+	}
+
+	if err := goval.NewRuleError(goval.StringRequired); err.NamedArgs() != nil {
+		t.Errorf("expect no params for the rule without args; got %v", err.NamedArgs())
+	}
+
+	if err := goval.NewRuleError(testRuleCode("not-registered"), 1); err.NamedArgs() != nil {
+		t.Errorf("expect no params for the code that is not registered; got %v", err.NamedArgs())
+	}
+
+	decoded := &goval.RuleError{Code: goval.StringMin, Args: []any{1}, Params: map[string]any{"min": "decoded"}}
+	if !reflect.DeepEqual(decoded.NamedArgs(), decoded.Params) {
+		t.Errorf("expect the params that are set; got %v", decoded.NamedArgs())
+	}
+}
+
+// unhashableCode is a RuleCoder whose dynamic type cannot be a map key.
+type unhashableCode struct {
+	Parts []string
+}
+
+func (c unhashableCode) Equal(other goval.RuleCoder) bool {
+	v, ok := other.(unhashableCode)
+	return ok && reflect.DeepEqual(c.Parts, v.Parts)
+}
+
+func (c unhashableCode) String() string { return fmt.Sprint(c.Parts) }
+
+func TestRuleError_UnhashableCode(t *testing.T) {
+	err := goval.NewRuleError(unhashableCode{Parts: []string{"a"}}, 1)
+	if got := err.Error(); got != `{"code":{"Parts":["a"]},"args":[1]}` {
+		t.Errorf("unexpected error: %s", got)
+	}
+
+	goval.MustRegisterRuleCode(goval.RuleCodeInfo{
+		Code:      unhashableCode{Parts: []string{"errors_test", "unhashable"}},
+		Namespace: "errors_test",
+		ID:        "errors_test.unhashable",
+		Arity:     1,
+		ArgNames:  []string{"n"},
+	})
+
+	err = goval.NewRuleError(unhashableCode{Parts: []string{"errors_test", "unhashable"}}, 1)
+	if !reflect.DeepEqual(err.NamedArgs(), map[string]any{"n": 1}) {
+		t.Errorf("expect the params of the registered code; got %v", err.NamedArgs())
+	}
+}
+
 func TestTextError(t *testing.T) {
 	err := goval.TextError("my-error")
 	exp := "my-error"
@@ -69,3 +129,124 @@ func TestErrors(t *testing.T) {
 		}
 	})
 }
+
+func TestRuleError_Is(t *testing.T) {
+	err := goval.NewKeyError("name", goval.NewRuleError(goval.StringMin, 3))
+	if !errors.Is(err, goval.NewRuleError(goval.StringMin)) {
+		t.Errorf("expect error matches the code %v", goval.StringMin)
+	}
+
+	if errors.Is(err, goval.NewRuleError(goval.StringMax)) {
+		t.Errorf("expect error does not match the code %v", goval.StringMax)
+	}
+}
+
+func TestUnmarshalError(t *testing.T) {
+	t.Run("round-trip", func(t *testing.T) {
+		orig := goval.Errors{
+			goval.NewRuleError(goval.NumberMin, 3),
+			goval.NewKeyError("name", goval.NewRuleError(goval.StringRequired)),
+			goval.NewKeyError("items", goval.Errors{
+				goval.NewKeyError("note", goval.TextError("my-error")),
+			}),
+		}
+
+		b, err := json.Marshal(orig)
+		if err != nil {
+			t.Fatalf("expect no error; got error: %v", err)
+		}
+
+		var got goval.Errors
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Fatalf("expect no error; got error: %v", err)
+		}
+
+		if got.Error() != orig.Error() {
+			t.Errorf("expect %s; got %s", orig, got)
+		}
+
+		if !errors.Is(got[1], goval.NewRuleError(goval.StringRequired)) {
+			t.Errorf("expect the code is resolved to %v; got %v", goval.StringRequired, got[1])
+		}
+
+		var ruleErr *goval.RuleError
+		if !errors.As(got[0], &ruleErr) || !ruleErr.Code.Equal(goval.NumberMin) {
+			t.Fatalf("expect the code is resolved to %v; got %v", goval.NumberMin, got[0])
+		}
+
+		if !reflect.DeepEqual(ruleErr.Args, []any{float64(3)}) {
+			t.Errorf("expect the args are decoded; got %v", ruleErr.Args)
+		}
+	})
+
+	t.Run("from a single value", func(t *testing.T) {
+		decoded, err := goval.UnmarshalError([]byte(`{"key":"age","err":"too young"}`))
+		if err != nil {
+			t.Fatalf("expect no error; got error: %v", err)
+		}
+
+		var keyErr *goval.KeyError
+		if !errors.As(decoded.Err, &keyErr) {
+			t.Fatalf("expect error type: %T; got error type: %T", keyErr, decoded.Err)
+		}
+
+		if keyErr.Key != "age" || keyErr.Err != goval.TextError("too young") {
+			t.Errorf("unexpected key error: %v", keyErr)
+		}
+	})
+
+	t.Run("as a field", func(t *testing.T) {
+		var resp struct {
+			Error goval.DecodedError `json:"error"`
+		}
+		if err := json.Unmarshal([]byte(`{"error":{"code":2000}}`), &resp); err != nil {
+			t.Fatalf("expect no error; got error: %v", err)
+		}
+
+		if !errors.Is(resp.Error.Err, goval.NewRuleError(goval.StringRequired)) {
+			t.Errorf("expect the code is resolved to %v; got %v", goval.StringRequired, resp.Error.Err)
+		}
+
+		decoded, err := goval.UnmarshalError([]byte(`null`))
+		if err != nil || decoded.Err != nil {
+			t.Errorf("expect a nil error from null; got %v, %v", decoded.Err, err)
+		}
+	})
+
+	t.Run("unknown code", func(t *testing.T) {
+		var ruleErr goval.RuleError
+		if err := json.Unmarshal([]byte(`{"code":99999}`), &ruleErr); err == nil {
+			t.Errorf("expect error on unknown code")
+		}
+	})
+
+	t.Run("custom decoder", func(t *testing.T) {
+		goval.RegisterRuleCoderDecoder(func(raw json.RawMessage) (goval.RuleCoder, bool) {
+			var s string
+			if err := json.Unmarshal(raw, &s); err != nil || s != string(customCode) {
+				return nil, false
+			}
+			return customCode, true
+		})
+
+		var ruleErr goval.RuleError
+		if err := json.Unmarshal([]byte(`{"code":"custom-code","args":["x"]}`), &ruleErr); err != nil {
+			t.Fatalf("expect no error; got error: %v", err)
+		}
+
+		if !ruleErr.Code.Equal(customCode) {
+			t.Errorf("expect the code is resolved to %v; got %v", customCode, ruleErr.Code)
+		}
+	})
+}
+
+type testRuleCode string
+
+const customCode = testRuleCode("custom-code")
+
+func (c testRuleCode) Equal(other goval.RuleCoder) bool {
+	v, ok := other.(testRuleCode)
+	return ok && c == v
+}
+
+func (c testRuleCode) String() string { return string(c) }
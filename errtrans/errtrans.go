@@ -45,29 +45,6 @@ func LanguageFromContext(ctx context.Context, fallback string) string {
 	return lang
 }
 
-var ruleCodeToTemplateKey = map[goval.RuleCoder]string{
-	goval.StringRequired: "strings.required",
-	goval.StringMin:      "strings.min",
-	goval.StringMax:      "strings.max",
-	goval.StringMatch:    "strings.match",
-	goval.StringIn:       "strings.in",
-	goval.StringInFold:   "strings.in_fold",
-	goval.NumberRequired: "numbers.required",
-	goval.NumberMin:      "numbers.min",
-	goval.NumberMax:      "numbers.max",
-	goval.NumberIn:       "numbers.in",
-	goval.SliceRequired:  "slices.required",
-	goval.SliceMin:       "slices.min",
-	goval.SliceMax:       "slices.max",
-	goval.TimeRequired:   "times.required",
-	goval.TimeMin:        "times.min",
-	goval.TimeMax:        "times.max",
-	goval.MapRequired:    "maps.required",
-	goval.MapMin:         "maps.min",
-	goval.MapMax:         "maps.max",
-	goval.PtrRequired:    "pointers.required",
-}
-
 type Option func(t *Translator)
 
 func WithBundle(bundle Bundle) Option {
@@ -178,10 +155,25 @@ func (t *Translator) translate(ctx context.Context, ruleErr *goval.RuleError, ke
 	return goval.TextError(buff.String())
 }
 
+// Translate translates the RuleError by using the template of the registered ID of the rule code.
+// For example, the goval.StringMin is registered as "strings.min", so the template is looked up by that key.
+// The template can access the args by their names, e.g. {{.Params.min}}, or by their positions, e.g. {{index .Args 0}}.
 func (t *Translator) Translate(ctx context.Context, err *goval.RuleError) error {
-	key, ok := ruleCodeToTemplateKey[err.Code]
+	info, ok := goval.LookupRuleCode(err.Code)
 	if !ok {
 		return goval.TextError(fmt.Sprintf("RuleError[code=%v] is not registered yet.", err.Code))
 	}
-	return t.translate(ctx, err, key)
+	return t.translate(ctx, withParams(err), info.ID)
+}
+
+// withParams returns a copy of the RuleError with the params, if the RuleError is created without them,
+// so the template can access the args by their names.
+func withParams(err *goval.RuleError) *goval.RuleError {
+	if err.Params != nil {
+		return err
+	}
+
+	cp := *err
+	cp.Params = err.NamedArgs()
+	return &cp
 }
@@ -6,6 +6,12 @@ import (
 	"testing"
 
 	"github.com/pkg-id/goval"
+	_ "github.com/pkg-id/goval/govalcron" // registers the codes, so the bundle is checked for their templates.
+	_ "github.com/pkg-id/goval/govalid"
+	_ "github.com/pkg-id/goval/govaliso"
+	_ "github.com/pkg-id/goval/govaljwt"
+	_ "github.com/pkg-id/goval/govalphone"
+	_ "github.com/pkg-id/goval/govalsemver"
 )
 
 type ruleCode bool
@@ -34,6 +40,20 @@ func TestTranslator_Translate(t *testing.T) {
 		}
 	})
 
+	t.Run("when the template uses the params", func(t *testing.T) {
+		ruleErr := goval.NewRuleError(goval.StringMin, 3)
+
+		err := tr.Translate(ctx, ruleErr)
+		if err.Error() != "Value must be at least 3 characters long." {
+			t.Errorf("expect the min param is rendered; got %v", err)
+		}
+
+		err = tr.Translate(ctx, &goval.RuleError{Code: goval.StringMin, Args: []any{3}})
+		if err.Error() != "Value must be at least 3 characters long." {
+			t.Errorf("expect the params are taken from the args; got %v", err)
+		}
+	})
+
 	t.Run("when use invalid rule code", func(t *testing.T) {
 		ruleErr := goval.NewRuleError(ruleCode(false))
 
@@ -65,6 +85,52 @@ func TestTranslator_Translate(t *testing.T) {
 	})
 }
 
+func TestDefaultBundle_Complete(t *testing.T) {
+	bundle, err := DefaultBundle()
+	if err != nil {
+		t.Fatalf("expect no error; got error: %v", err)
+	}
+
+	for lang, dict := range bundle {
+		for _, info := range goval.RuleCodes() {
+			if info.Namespace == "errtrans_test" {
+				continue
+			}
+
+			if _, ok := dict[info.ID]; !ok {
+				t.Errorf("expect the %q dictionary has a template for %q", lang, info.ID)
+			}
+		}
+	}
+}
+
+func TestTranslator_Translate_RegisteredCode(t *testing.T) {
+	goval.MustRegisterRuleCode(goval.RuleCodeInfo{
+		Code:      customCode("has-prefix"),
+		Namespace: "errtrans_test",
+		ID:        "errtrans_test.has_prefix",
+		Arity:     1,
+		ArgNames:  []string{"prefix"},
+	})
+
+	bundle := Bundle{"en": Dictionary{"errtrans_test.has_prefix": "Value must start with {{index .Args 0}}."}}
+	tr := NewTranslator(WithBundle(bundle))
+
+	err := tr.Translate(context.Background(), goval.NewRuleError(customCode("has-prefix"), ":"))
+	if err.Error() != "Value must start with :." {
+		t.Errorf("expect the registered code is translated; got %v", err)
+	}
+}
+
+type customCode string
+
+func (c customCode) Equal(other goval.RuleCoder) bool {
+	v, ok := other.(customCode)
+	return ok && c == v
+}
+
+func (c customCode) String() string { return string(c) }
+
 func BenchmarkTranslator_Translate(b *testing.B) {
 	ctx := context.Background()
 	bundle, _ := DefaultBundle()
@@ -88,30 +88,31 @@ type FunctionValidatorConstraint[T any] interface {
 // want to delay the execution of `f` and `g` until the new function is executed.
 // Let's call the new function `h`. When `h` is executed, `f` will be executed first. If `f` executes without error,
 // then `g` will be executed next. If `h` returns any error it will be an error that returned either `f` or `g`.
+//
+// If `f` or `g` panics, the panic is recovered into an InternalError, unless the context is created
+// by ContextWithRepanic.
 func Chain[T any, Func FunctionValidatorConstraint[T]](f, g Func) Func {
-	return func(ctx context.Context, value T) error {
-		return execChain(ctx, value, f, g)
-	}
-}
-
-// execChain executes the given functions in the order they are given.
-// If any of the functions returns an error, the execution will be stopped and the error will be returned.
-func execChain[T any, Func FunctionValidatorConstraint[T]](ctx context.Context, value T, functions ...Func) error {
-	for _, fn := range functions {
-		if err := fn(ctx, value); err != nil {
+	return func(ctx context.Context, value T) (err error) {
+		rule := f
+		defer recoverPanic(ctx, &err, &rule)
+		if err := f(ctx, value); err != nil {
 			return err
 		}
+
+		rule = g
+		return g(ctx, value)
 	}
-	return nil
 }
 
 // Named creates a new validator that returns KeyErrors if actual validator returns an error.
+// The name is prepended to the path of an InternalError, so a recovered panic tells where it happened.
 func Named[T any, F RuleValidator[T]](name string, value T, validator F) Validator {
 	return ValidatorFunc(func(ctx context.Context) error {
-		if err := validator.Validate(ctx, value); err != nil {
+		err := protect(ctx, validator, func() error { return validator.Validate(ctx, value) })
+		if err != nil {
 			var ie *InternalError
 			if errors.As(err, &ie) {
-				return ie
+				return ie.withKey(name)
 			}
 			return NewKeyError(name, err)
 		}
@@ -134,46 +135,35 @@ func EachFunc[T any, V []T](validator RuleValidatorFunc[T]) RuleValidator[V] {
 
 func Bind[T any](value T, validator RuleValidator[T]) Validator {
 	return ValidatorFunc(func(ctx context.Context) error {
-		return validator.Validate(ctx, value)
+		return protect(ctx, validator, func() error { return validator.Validate(ctx, value) })
 	})
 }
 
-// Execute executes the given validators and collects the errors into a single error
+// Execute executes the given validators and collects the errors into a single error.
+// A panic in any of the validators is recovered into an InternalError, unless the context is created
+// by ContextWithRepanic.
 func Execute(ctx context.Context, validators ...Validator) error {
 	return execute(ctx, validators)
 }
 
 // execute executes the given validators and collects the errors into a single error.
+// It stops at the first error that is not a validation error, e.g. *InternalError, and returns it.
 func execute(ctx context.Context, validators []Validator) error {
-	internalError := make(chan error, 1)
-	validateError := make(chan error, 1)
-	reducer := validatorReducer(ctx, internalError)
-	go func() {
-		validateError <- funcs.
-			Reduce(validators, Errors{}, reducer).
-			NilIfEmpty()
-	}()
-	select {
-	case ie := <-internalError:
-		return ie
-	case ve := <-validateError:
-		return ve
-	}
-}
+	var errs Errors
+	for _, validator := range validators {
+		err := protect(ctx, validator, func() error { return validator.Validate(ctx) })
+		if err == nil {
+			continue
+		}
 
-func validatorReducer(ctx context.Context, internalError chan error) func(errs Errors, validator Validator) Errors {
-	return func(errs Errors, validator Validator) Errors {
-		err := validator.Validate(ctx)
-		if err != nil {
-			switch err.(type) {
-			default: // *InternalError or something else.
-				internalError <- err
-			case *RuleError, *KeyError, Errors, TextError:
-				errs = append(errs, err)
-			}
+		switch err.(type) {
+		default: // *InternalError or something else.
+			return err
+		case *RuleError, *KeyError, Errors, TextError:
+			errs = append(errs, err)
 		}
-		return errs
 	}
+	return errs.NilIfEmpty()
 }
 
 // Use executes the given validator function.
@@ -0,0 +1,232 @@
+package govalcron
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg-id/goval"
+)
+
+// Fields selects the number of the fields accepted by Parse, the descriptors are always accepted.
+type Fields int
+
+const (
+	// AnyFields accepts both 5 and 6 fields.
+	AnyFields Fields = iota
+	// FiveFields accepts the standard fields: minute, hour, day of month, month and day of week.
+	FiveFields
+	// SixFields accepts the seconds followed by the standard fields.
+	SixFields
+)
+
+// descriptors are the predefined schedules in the 6 fields form.
+var descriptors = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// field describes a field of the expression.
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+	anyMark  bool // the field accepts "?" as "*".
+}
+
+var (
+	secondField = field{name: "second", min: 0, max: 59}
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day_of_month", min: 1, max: 31, anyMark: true}
+	monthField  = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowField = field{name: "day_of_week", min: 0, max: 7, anyMark: true, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// Parse parses the cron expression. The expression is either the fields separated by spaces, see Fields,
+// or a descriptor: "@yearly" or "@annually", "@monthly", "@weekly", "@daily" or "@midnight", "@hourly",
+// and "@every" followed by a positive time.Duration, e.g. "@every 1h30m". The 5 fields form runs at second 0.
+//
+// Each field is a list of items separated by commas. An item is "*", a value, or a range of values "1-5",
+// optionally followed by a step, e.g. "*/15" or "0-30/10", where "5/15" is a range from 5 to the maximum.
+// The months accept the names "JAN" to "DEC" and the days of week accept the names "SUN" to "SAT",
+// case-insensitively, where both 0 and 7 are Sunday. The days of month and week also accept "?" as "*".
+// If both the day of month and the day of week are restricted, that is neither starts with "*" or "?",
+// the expression runs on the days matching either of them.
+//
+// It returns a goval.RuleError with CodeExpression if the expression is malformed as a whole, CodeField with
+// the field name and the item if an item is malformed, CodeRange with the field name and its bounds if a value
+// is out of range, and CodeNever if the expression never runs, e.g. "0 0 30 2 *".
+func Parse(expr string, fields Fields) (Schedule, error) {
+	if strings.HasPrefix(expr, "@every ") {
+		d, err := time.ParseDuration(strings.TrimSpace(expr[len("@every "):]))
+		if err != nil || d <= 0 {
+			return Schedule{}, goval.NewRuleError(CodeExpression)
+		}
+		return Schedule{every: d}, nil
+	}
+
+	if spec, ok := descriptors[expr]; ok {
+		expr, fields = spec, SixFields
+	}
+
+	parts := strings.Fields(expr)
+	switch {
+	case len(parts) == 5 && fields != SixFields:
+		parts = append([]string{"0"}, parts...)
+	case len(parts) == 6 && fields != FiveFields:
+	default:
+		return Schedule{}, goval.NewRuleError(CodeExpression)
+	}
+
+	var s Schedule
+	for i, target := range []struct {
+		field field
+		bits  *uint64
+	}{
+		{secondField, &s.second},
+		{minuteField, &s.minute},
+		{hourField, &s.hour},
+		{domField, &s.dom},
+		{monthField, &s.month},
+		{dowField, &s.dow},
+	} {
+		bits, err := target.field.parse(parts[i])
+		if err != nil {
+			return Schedule{}, err
+		}
+		*target.bits = bits
+	}
+
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	s.domStar = strings.HasPrefix(parts[3], "*") || strings.HasPrefix(parts[3], "?")
+	s.dowStar = strings.HasPrefix(parts[5], "*") || strings.HasPrefix(parts[5], "?")
+
+	if s.Next(reference).IsZero() {
+		return Schedule{}, goval.NewRuleError(CodeNever)
+	}
+	return s, nil
+}
+
+// Options is the policy of the Cron rule.
+type Options struct {
+	// Fields is the accepted number of the fields, the zero value accepts both 5 and 6 fields.
+	Fields Fields
+	// MinInterval rejects the expressions that may run twice within the duration, zero allows any interval.
+	// The runs are computed in UTC, so the DST transitions are not considered.
+	MinInterval time.Duration
+}
+
+// Cron ensures that the string is a cron expression, see Parse, that satisfies the options.
+// Besides the errors of Parse, it returns CodeInterval with the minimum interval, e.g. "5m0s",
+// if the expression may run more often than MinInterval.
+func Cron(opts Options) goval.StringValidator {
+	return func(ctx context.Context, value string) error {
+		s, err := Parse(value, opts.Fields)
+		if err != nil {
+			return err
+		}
+
+		if opts.MinInterval > 0 && s.runsWithin(opts.MinInterval) {
+			return goval.NewRuleError(CodeInterval, opts.MinInterval.String())
+		}
+		return nil
+	}
+}
+
+// parse parses the comma-separated items of the field into a bit set of the values.
+func (f field) parse(s string) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(s, ",") {
+		b, err := f.parseItem(item)
+		if err != nil {
+			return 0, err
+		}
+		bits |= b
+	}
+	return bits, nil
+}
+
+// parseItem parses an item of the field, see Parse.
+func (f field) parseItem(item string) (uint64, error) {
+	span, stepText, hasStep := strings.Cut(item, "/")
+	lo, hi := f.min, f.max
+	if span != "*" && !(span == "?" && f.anyMark && !hasStep) {
+		loText, hiText, isRange := strings.Cut(span, "-")
+		var err error
+		if lo, err = f.value(loText, item); err != nil {
+			return 0, err
+		}
+
+		switch {
+		case isRange:
+			if hi, err = f.value(hiText, item); err != nil {
+				return 0, err
+			}
+		case !hasStep:
+			hi = lo
+		}
+
+		if lo > hi {
+			return 0, goval.NewRuleError(CodeField, f.name, item)
+		}
+	}
+
+	step := 1
+	if hasStep {
+		n, err := strconv.Atoi(stepText)
+		if err != nil || n <= 0 || !isDigits(stepText) {
+			return 0, goval.NewRuleError(CodeField, f.name, item)
+		}
+		step = n
+	}
+
+	var bits uint64
+	for v := lo; v <= hi; v += step {
+		bits |= 1 << v
+	}
+	return bits, nil
+}
+
+// value parses a number or a name of the field.
+func (f field) value(s, item string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+
+	if !isDigits(s) {
+		return 0, goval.NewRuleError(CodeField, f.name, item)
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, goval.NewRuleError(CodeRange, f.name, f.min, f.max)
+	}
+	return v, nil
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
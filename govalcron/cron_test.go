@@ -0,0 +1,82 @@
+package govalcron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg-id/goval/govalcron"
+	"github.com/pkg-id/goval/internal/ruletest"
+)
+
+func TestCron(t *testing.T) {
+	ruletest.Run(t, govalcron.Cron(govalcron.Options{}), []ruletest.Case{
+		{Desc: "every minute", Input: "* * * * *"},
+		{Desc: "with seconds", Input: "30 */5 * * * *"},
+		{Desc: "lists and ranges", Input: "0,30 9-17 * * 1-5"},
+		{Desc: "range with step", Input: "0-30/10 * * * *"},
+		{Desc: "start with step", Input: "5/15 * * * *"},
+		{Desc: "names", Input: "0 0 1 jan,JUL MON-fri"},
+		{Desc: "sunday as 7", Input: "0 0 * * 7"},
+		{Desc: "question mark", Input: "0 0 ? * MON"},
+		{Desc: "extra spaces", Input: " 0  0 * * * "},
+		{Desc: "leap day", Input: "0 0 29 2 *"},
+		{Desc: "daily", Input: "@daily"},
+		{Desc: "annually", Input: "@annually"},
+		{Desc: "every", Input: "@every 1h30m"},
+		{Desc: "empty", Input: "", Code: govalcron.CodeExpression},
+		{Desc: "too few fields", Input: "* * * *", Code: govalcron.CodeExpression},
+		{Desc: "too many fields", Input: "* * * * * * *", Code: govalcron.CodeExpression},
+		{Desc: "garbage", Input: "99 99 99 99 99 nonsense", Code: govalcron.CodeRange, Args: []any{"second", 0, 59}},
+		{Desc: "minute out of range", Input: "60 * * * *", Code: govalcron.CodeRange, Args: []any{"minute", 0, 59}},
+		{Desc: "hour out of range", Input: "0 24 * * *", Code: govalcron.CodeRange, Args: []any{"hour", 0, 23}},
+		{Desc: "day of month zero", Input: "0 0 0 * *", Code: govalcron.CodeRange, Args: []any{"day_of_month", 1, 31}},
+		{Desc: "month out of range", Input: "0 0 1 13 *", Code: govalcron.CodeRange, Args: []any{"month", 1, 12}},
+		{Desc: "day of week out of range", Input: "0 0 * * 8", Code: govalcron.CodeRange, Args: []any{"day_of_week", 0, 7}},
+		{Desc: "huge value", Input: "99999999999999999999 * * * *", Code: govalcron.CodeRange},
+		{Desc: "unknown name", Input: "0 0 * * FUN", Code: govalcron.CodeField, Args: []any{"day_of_week", "FUN"}},
+		{Desc: "month name in day of week", Input: "0 0 * * JAN", Code: govalcron.CodeField},
+		{Desc: "reversed range", Input: "0 5-2 * * *", Code: govalcron.CodeField, Args: []any{"hour", "5-2"}},
+		{Desc: "zero step", Input: "*/0 * * * *", Code: govalcron.CodeField, Args: []any{"minute", "*/0"}},
+		{Desc: "negative step", Input: "*/-1 * * * *", Code: govalcron.CodeField},
+		{Desc: "empty item", Input: "1,,2 * * * *", Code: govalcron.CodeField},
+		{Desc: "question mark in minute", Input: "? * * * *", Code: govalcron.CodeField},
+		{Desc: "never", Input: "0 0 30 2 *", Code: govalcron.CodeNever},
+		{Desc: "unknown descriptor", Input: "@reboot", Code: govalcron.CodeExpression},
+		{Desc: "every without duration", Input: "@every", Code: govalcron.CodeExpression},
+		{Desc: "every negative", Input: "@every -5m", Code: govalcron.CodeExpression},
+	})
+}
+
+func TestCron_Fields(t *testing.T) {
+	ruletest.Run(t, govalcron.Cron(govalcron.Options{Fields: govalcron.FiveFields}), []ruletest.Case{
+		{Desc: "five", Input: "0 0 * * *"},
+		{Desc: "descriptor", Input: "@hourly"},
+		{Desc: "six", Input: "0 0 0 * * *", Code: govalcron.CodeExpression},
+	})
+
+	ruletest.Run(t, govalcron.Cron(govalcron.Options{Fields: govalcron.SixFields}), []ruletest.Case{
+		{Desc: "six", Input: "0 0 0 * * *"},
+		{Desc: "descriptor", Input: "@weekly"},
+		{Desc: "five", Input: "0 0 * * *", Code: govalcron.CodeExpression},
+	})
+}
+
+func TestCron_MinInterval(t *testing.T) {
+	ruletest.Run(t, govalcron.Cron(govalcron.Options{MinInterval: 5 * time.Minute}), []ruletest.Case{
+		{Desc: "every 5 minutes", Input: "*/5 * * * *"},
+		{Desc: "hourly", Input: "@hourly"},
+		{Desc: "every 10 minutes", Input: "@every 10m"},
+		{Desc: "every minute", Input: "* * * * *", Code: govalcron.CodeInterval, Args: []any{"5m0s"}},
+		{Desc: "every 4 minutes", Input: "*/4 * * * *", Code: govalcron.CodeInterval},
+		{Desc: "uneven list", Input: "0,10,12 * * * *", Code: govalcron.CodeInterval},
+		{Desc: "seconds", Input: "*/30 */5 * * * *", Code: govalcron.CodeInterval},
+		{Desc: "every 1 minute", Input: "@every 1m", Code: govalcron.CodeInterval},
+	})
+
+	ruletest.Run(t, govalcron.Cron(govalcron.Options{MinInterval: 2 * time.Hour}), []ruletest.Case{
+		{Desc: "across midnight", Input: "0 0,23 * * *", Code: govalcron.CodeInterval, Args: []any{"2h0m0s"}},
+		{Desc: "across month end", Input: "0 0,23 1,31 * *", Code: govalcron.CodeInterval},
+		{Desc: "not on consecutive days", Input: "0 0,23 * * MON,WED"},
+		{Desc: "month start and middle", Input: "0 0,23 1,15 * *"},
+	})
+}
@@ -0,0 +1,29 @@
+// Package govalcron provides the rules for the cron expressions: the standard 5 fields, the 6 fields with seconds,
+// and the descriptors such as "@daily" and "@every 5m". The expressions are parsed, so the ranges, steps,
+// lists and names are checked, and the firing interval can be limited by computing the run times.
+//
+//	goval.Named("schedule", job.Schedule, govalcron.Cron(govalcron.Options{MinInterval: 5 * time.Minute}))
+package govalcron
+
+import (
+	"github.com/pkg-id/goval"
+	"github.com/pkg-id/goval/internal/rulecode"
+)
+
+const (
+	CodeExpression = rulecode.Code("govalcron.expression")
+	CodeField      = rulecode.Code("govalcron.field")
+	CodeRange      = rulecode.Code("govalcron.range")
+	CodeNever      = rulecode.Code("govalcron.never")
+	CodeInterval   = rulecode.Code("govalcron.interval")
+)
+
+func init() {
+	goval.MustRegisterRuleCode(
+		rulecode.Info(CodeExpression),
+		rulecode.Info(CodeField, "field", "value"),
+		rulecode.Info(CodeRange, "field", "min", "max"),
+		rulecode.Info(CodeNever),
+		rulecode.Info(CodeInterval, "min"),
+	)
+}
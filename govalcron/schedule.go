@@ -0,0 +1,111 @@
+package govalcron
+
+import "time"
+
+// horizonDays bounds the search of the run days, 8 years cover the leap days even across a non-leap century.
+const horizonDays = 8 * 366
+
+// reference is the time the run times are computed from when checking an expression.
+var reference = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second)
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	second, minute, hour, dom, month, dow uint64 // the bit sets of the values of the fields.
+	domStar, dowStar                      bool   // the day fields start with "*" or "?".
+	every                                 time.Duration
+}
+
+// Next returns the first run time after t, in the location of t. It returns the zero time.Time
+// if the schedule does not run in the next 8 years.
+func (s Schedule) Next(t time.Time) time.Time {
+	if s.every > 0 {
+		return t.Add(s.every)
+	}
+
+	t = t.Truncate(time.Second).Add(time.Second)
+	sod := t.Hour()*3600 + t.Minute()*60 + t.Second()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	for i := 0; i < horizonDays; i++ {
+		if s.runsOn(day) {
+			if sec, ok := s.nextInDay(sod); ok {
+				return time.Date(day.Year(), day.Month(), day.Day(), sec/3600, sec/60%60, sec%60, 0, day.Location())
+			}
+		}
+		sod, day = 0, day.AddDate(0, 0, 1)
+	}
+	return time.Time{}
+}
+
+// runsOn reports whether the schedule runs on the day.
+func (s Schedule) runsOn(day time.Time) bool {
+	if s.month&(1<<uint(day.Month())) == 0 {
+		return false
+	}
+
+	domOK := s.dom&(1<<uint(day.Day())) != 0
+	dowOK := s.dow&(1<<uint(day.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domOK && dowOK
+	}
+	return domOK || dowOK
+}
+
+// nextInDay returns the first run time of a day at or after the given second of the day.
+func (s Schedule) nextInDay(sod int) (int, bool) {
+	h0, m0, s0 := sod/3600, sod/60%60, sod%60
+	for h := h0; h < 24; h++ {
+		if s.hour&(1<<uint(h)) == 0 {
+			continue
+		}
+
+		for m := 0; m < 60; m++ {
+			if h == h0 && m < m0 || s.minute&(1<<uint(m)) == 0 {
+				continue
+			}
+
+			for sec := 0; sec < 60; sec++ {
+				if h == h0 && m == m0 && sec < s0 || s.second&(1<<uint(sec)) == 0 {
+					continue
+				}
+				return h*3600 + m*60 + sec, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// runsWithin reports whether two consecutive runs may be closer than the duration.
+// The runs within a day are the same on every run day, so they are computed for a single day,
+// and the gap between the last and the first run of a day only matters if it runs on two consecutive days.
+func (s Schedule) runsWithin(d time.Duration) bool {
+	if s.every > 0 {
+		return s.every < d
+	}
+
+	first, ok := s.nextInDay(0)
+	if !ok {
+		return false
+	}
+
+	last := first
+	for sec, ok := s.nextInDay(first + 1); ok; sec, ok = s.nextInDay(sec + 1) {
+		if time.Duration(sec-last)*time.Second < d {
+			return true
+		}
+		last = sec
+	}
+
+	if time.Duration(24*3600-last+first)*time.Second >= d {
+		return false
+	}
+
+	day, ranYesterday := reference.Add(time.Second), false
+	for i := 0; i < horizonDays; i++ {
+		runs := s.runsOn(day)
+		if runs && ranYesterday {
+			return true
+		}
+		day, ranYesterday = day.AddDate(0, 0, 1), runs
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package govalcron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg-id/goval/govalcron"
+)
+
+func TestSchedule_Next(t *testing.T) {
+	from := time.Date(2024, time.February, 28, 23, 59, 30, 0, time.UTC)
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{expr: "* * * * *", want: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{expr: "*/10 * * * * *", want: time.Date(2024, time.February, 28, 23, 59, 40, 0, time.UTC)},
+		{expr: "0 0 29 2 *", want: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 12 1 * *", want: time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)},
+		{expr: "0 9 * * MON", want: time.Date(2024, time.March, 4, 9, 0, 0, 0, time.UTC)},
+		{expr: "0 9 13 * FRI", want: time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)},
+		{expr: "0 9 */2 * FRI", want: time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)},
+		{expr: "0 9 * JAN *", want: time.Date(2025, time.January, 1, 9, 0, 0, 0, time.UTC)},
+		{expr: "@yearly", want: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{expr: "@every 90s", want: from.Add(90 * time.Second)},
+	}
+
+	for _, tc := range tests {
+		s, err := govalcron.Parse(tc.expr, govalcron.AnyFields)
+		if err != nil {
+			t.Errorf("Parse(%q): expect no error; got %v", tc.expr, err)
+			continue
+		}
+
+		if got := s.Next(from); !got.Equal(tc.want) {
+			t.Errorf("Next(%q): expect %v; got %v", tc.expr, tc.want, got)
+		}
+	}
+}
+
+func TestSchedule_NextLocation(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*3600)
+	s, err := govalcron.Parse("0 8 * * *", govalcron.FiveFields)
+	if err != nil {
+		t.Fatalf("expect no error; got %v", err)
+	}
+
+	got := s.Next(time.Date(2024, time.May, 1, 8, 0, 0, 0, jakarta))
+	want := time.Date(2024, time.May, 2, 8, 0, 0, 0, jakarta)
+	if !got.Equal(want) || got.Location() != jakarta {
+		t.Errorf("expect %v; got %v", want, got)
+	}
+}
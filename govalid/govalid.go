@@ -0,0 +1,36 @@
+// Package govalid provides the rules for the Indonesian identifiers: NIK, NPWP, mobile numbers and postal codes.
+//
+// The rules return goval.StringValidator, so they can be used directly or chained by With:
+//
+//	goval.Named("nik", req.NIK, govalid.NIK())
+//	goval.Named("phone", req.Phone, goval.String().Required().With(govalid.Mobile()))
+package govalid
+
+import (
+	"github.com/pkg-id/goval"
+	"github.com/pkg-id/goval/internal/rulecode"
+)
+
+const (
+	CodeNIK            = rulecode.Code("govalid.nik")
+	CodeNIKRegion      = rulecode.Code("govalid.nik_region")
+	CodeNIKBirthDate   = rulecode.Code("govalid.nik_birth_date")
+	CodeNPWP           = rulecode.Code("govalid.npwp")
+	CodeNPWPChecksum   = rulecode.Code("govalid.npwp_checksum")
+	CodeMobile         = rulecode.Code("govalid.mobile")
+	CodeMobileOperator = rulecode.Code("govalid.mobile_operator")
+	CodePostalCode     = rulecode.Code("govalid.postal_code")
+)
+
+func init() {
+	goval.MustRegisterRuleCode(
+		rulecode.Info(CodeNIK),
+		rulecode.Info(CodeNIKRegion, "province"),
+		rulecode.Info(CodeNIKBirthDate),
+		rulecode.Info(CodeNPWP),
+		rulecode.Info(CodeNPWPChecksum),
+		rulecode.Info(CodeMobile),
+		rulecode.Info(CodeMobileOperator, "prefix"),
+		rulecode.Info(CodePostalCode),
+	)
+}
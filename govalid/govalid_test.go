@@ -0,0 +1,18 @@
+package govalid_test
+
+import (
+	"testing"
+
+	"github.com/pkg-id/goval"
+)
+
+func TestRuleCodes_Registered(t *testing.T) {
+	info, ok := goval.LookupRuleCodeID("govalid.nik_region")
+	if !ok {
+		t.Fatalf("expect the code is registered")
+	}
+
+	if info.Namespace != "govalid" || len(info.ArgNames) != 1 || info.ArgNames[0] != "province" {
+		t.Errorf("unexpected info: %+v", info)
+	}
+}
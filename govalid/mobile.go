@@ -0,0 +1,91 @@
+package govalid
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg-id/goval"
+	"github.com/pkg-id/goval/internal/digits"
+)
+
+// Operator is an Indonesian mobile network operator.
+type Operator string
+
+// The operators detected by ParseMobile.
+const (
+	OperatorTelkomsel Operator = "telkomsel"
+	OperatorIndosat   Operator = "indosat"
+	OperatorXL        Operator = "xl"
+	OperatorAxis      Operator = "axis"
+	OperatorTri       Operator = "tri"
+	OperatorSmartfren Operator = "smartfren"
+)
+
+// operatorPrefixes are the operators by the first 3 digits of the national number without the trunk prefix "0".
+var operatorPrefixes = map[string]Operator{
+	"811": OperatorTelkomsel, "812": OperatorTelkomsel, "813": OperatorTelkomsel,
+	"821": OperatorTelkomsel, "822": OperatorTelkomsel, "823": OperatorTelkomsel,
+	"851": OperatorTelkomsel, "852": OperatorTelkomsel, "853": OperatorTelkomsel,
+	"814": OperatorIndosat, "815": OperatorIndosat, "816": OperatorIndosat,
+	"855": OperatorIndosat, "856": OperatorIndosat, "857": OperatorIndosat, "858": OperatorIndosat,
+	"817": OperatorXL, "818": OperatorXL, "819": OperatorXL,
+	"859": OperatorXL, "877": OperatorXL, "878": OperatorXL,
+	"831": OperatorAxis, "832": OperatorAxis, "833": OperatorAxis, "838": OperatorAxis,
+	"895": OperatorTri, "896": OperatorTri, "897": OperatorTri, "898": OperatorTri, "899": OperatorTri,
+	"881": OperatorSmartfren, "882": OperatorSmartfren, "883": OperatorSmartfren, "884": OperatorSmartfren,
+	"885": OperatorSmartfren, "886": OperatorSmartfren, "887": OperatorSmartfren, "888": OperatorSmartfren, "889": OperatorSmartfren,
+}
+
+// MobileInfo is a parsed Indonesian mobile number.
+type MobileInfo struct {
+	E164     string   // the number in E.164, e.g. "+6281234567890".
+	Operator Operator // the operator of the number prefix.
+}
+
+// ParseMobile parses an Indonesian mobile number in the national form "08xx" or the international form "+628xx",
+// the "+" may be omitted and the digits may be grouped by spaces, dots or hyphens.
+// The national number after the trunk prefix "0" has 9 to 12 digits, and its "08xx" prefix must be assigned to an operator.
+//
+// It returns a goval.RuleError with CodeMobile if the number is not in either form,
+// and CodeMobileOperator with the "08xx" prefix if the prefix is not assigned to an operator.
+func ParseMobile(number string) (MobileInfo, error) {
+	number = digits.Strip(number, " .-")
+
+	var national string
+	switch {
+	case strings.HasPrefix(number, "+62"):
+		national = number[3:]
+	case strings.HasPrefix(number, "62"):
+		national = number[2:]
+	case strings.HasPrefix(number, "0"):
+		national = number[1:]
+	}
+
+	if len(national) < 9 || len(national) > 12 || national[0] != '8' || !digits.Valid(national) {
+		return MobileInfo{}, goval.NewRuleError(CodeMobile)
+	}
+
+	operator, ok := operatorPrefixes[national[:3]]
+	if !ok {
+		return MobileInfo{}, goval.NewRuleError(CodeMobileOperator, "0"+national[:3])
+	}
+	return MobileInfo{E164: "+62" + national, Operator: operator}, nil
+}
+
+// NormalizeMobile returns the Indonesian mobile number in E.164, e.g. "0812-3456-7890" becomes "+6281234567890".
+// It returns the same errors as ParseMobile.
+func NormalizeMobile(number string) (string, error) {
+	info, err := ParseMobile(number)
+	if err != nil {
+		return "", err
+	}
+	return info.E164, nil
+}
+
+// Mobile ensures that the string is an Indonesian mobile number, see ParseMobile.
+func Mobile() goval.StringValidator {
+	return func(ctx context.Context, value string) error {
+		_, err := ParseMobile(value)
+		return err
+	}
+}
@@ -0,0 +1,58 @@
+package govalid_test
+
+import (
+	"testing"
+
+	"github.com/pkg-id/goval/govalid"
+	"github.com/pkg-id/goval/internal/ruletest"
+)
+
+func TestParseMobile(t *testing.T) {
+	tests := []struct {
+		input    string
+		e164     string
+		operator govalid.Operator
+	}{
+		{input: "081234567890", e164: "+6281234567890", operator: govalid.OperatorTelkomsel},
+		{input: "+62 857-1234-5678", e164: "+6285712345678", operator: govalid.OperatorIndosat},
+		{input: "6287812345678", e164: "+6287812345678", operator: govalid.OperatorXL},
+		{input: "0838.1234.567", e164: "+628381234567", operator: govalid.OperatorAxis},
+		{input: "089612345678", e164: "+6289612345678", operator: govalid.OperatorTri},
+		{input: "0881234567", e164: "+62881234567", operator: govalid.OperatorSmartfren},
+	}
+
+	for _, tc := range tests {
+		info, err := govalid.ParseMobile(tc.input)
+		if err != nil {
+			t.Errorf("%s: expect no error; got %v", tc.input, err)
+			continue
+		}
+
+		if info.E164 != tc.e164 || info.Operator != tc.operator {
+			t.Errorf("%s: expect %s of %s; got %+v", tc.input, tc.e164, tc.operator, info)
+		}
+	}
+}
+
+func TestNormalizeMobile(t *testing.T) {
+	got, err := govalid.NormalizeMobile("0812-3456-7890")
+	if err != nil || got != "+6281234567890" {
+		t.Errorf("expect +6281234567890; got %q, %v", got, err)
+	}
+
+	if _, err := govalid.NormalizeMobile("021-1234567"); err == nil {
+		t.Errorf("expect an error for a landline number")
+	}
+}
+
+func TestMobile(t *testing.T) {
+	ruletest.Run(t, govalid.Mobile(), []ruletest.Case{
+		{Desc: "ok", Input: "081234567890"},
+		{Desc: "landline", Input: "0211234567", Code: govalid.CodeMobile},
+		{Desc: "too short", Input: "08123456", Code: govalid.CodeMobile},
+		{Desc: "too long", Input: "08123456789012", Code: govalid.CodeMobile},
+		{Desc: "foreign", Input: "+6591234567", Code: govalid.CodeMobile},
+		{Desc: "no prefix", Input: "81234567890", Code: govalid.CodeMobile},
+		{Desc: "unassigned prefix", Input: "080012345678", Code: govalid.CodeMobileOperator, Args: []any{"0800"}},
+	})
+}
@@ -0,0 +1,89 @@
+package govalid
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg-id/goval"
+	"github.com/pkg-id/goval/internal/digits"
+)
+
+// provinces are the province codes of Kemendagri, the first two digits of NIK.
+var provinces = map[string]string{
+	"11": "Aceh", "12": "Sumatera Utara", "13": "Sumatera Barat", "14": "Riau", "15": "Jambi",
+	"16": "Sumatera Selatan", "17": "Bengkulu", "18": "Lampung", "19": "Kepulauan Bangka Belitung", "21": "Kepulauan Riau",
+	"31": "DKI Jakarta", "32": "Jawa Barat", "33": "Jawa Tengah", "34": "DI Yogyakarta", "35": "Jawa Timur", "36": "Banten",
+	"51": "Bali", "52": "Nusa Tenggara Barat", "53": "Nusa Tenggara Timur",
+	"61": "Kalimantan Barat", "62": "Kalimantan Tengah", "63": "Kalimantan Selatan", "64": "Kalimantan Timur", "65": "Kalimantan Utara",
+	"71": "Sulawesi Utara", "72": "Sulawesi Tengah", "73": "Sulawesi Selatan", "74": "Sulawesi Tenggara", "75": "Gorontalo", "76": "Sulawesi Barat",
+	"81": "Maluku", "82": "Maluku Utara",
+	"91": "Papua", "92": "Papua Barat", "93": "Papua Selatan", "94": "Papua Tengah", "95": "Papua Pegunungan", "96": "Papua Barat Daya",
+}
+
+// NIKInfo is the information encoded in a NIK (Nomor Induk Kependudukan).
+type NIKInfo struct {
+	Province  string    // the 2-digit province code, e.g. "32".
+	Regency   string    // the 4-digit regency or city code, e.g. "3201".
+	District  string    // the 6-digit district code, e.g. "320101".
+	BirthDate time.Time // the birth date in UTC.
+	Female    bool      // the birth day is encoded with +40 for women.
+	Serial    string    // the 4-digit serial number.
+}
+
+// ProvinceName returns the name of the province, e.g. "Jawa Barat".
+func (n NIKInfo) ProvinceName() string {
+	return provinces[n.Province]
+}
+
+// ParseNIK parses the 16-digit NIK: the province, regency and district codes, the birth date as DDMMYY
+// where the day is added by 40 for women, and the serial number.
+//
+// The century of the birth date is not encoded, the 20YY is used unless it is in the future.
+// It returns a goval.RuleError with CodeNIK if the NIK is not 16 digits or its serial number is zero,
+// CodeNIKRegion with the province if the region codes are unknown, and CodeNIKBirthDate if the birth date is not a valid date.
+func ParseNIK(nik string) (NIKInfo, error) {
+	return parseNIK(nik, time.Now())
+}
+
+// parseNIK is like ParseNIK, the century is resolved relative to the given time.
+func parseNIK(nik string, now time.Time) (NIKInfo, error) {
+	if len(nik) != 16 || !digits.Valid(nik) || nik[12:] == "0000" {
+		return NIKInfo{}, goval.NewRuleError(CodeNIK)
+	}
+
+	info := NIKInfo{Province: nik[:2], Regency: nik[:4], District: nik[:6], Serial: nik[12:]}
+	if _, ok := provinces[info.Province]; !ok || nik[2:4] == "00" || nik[4:6] == "00" {
+		return NIKInfo{}, goval.NewRuleError(CodeNIKRegion, info.Province)
+	}
+
+	day, month, year := digits.Atoi(nik[6:8]), digits.Atoi(nik[8:10]), digits.Atoi(nik[10:12])
+	if day > 40 {
+		day -= 40
+		info.Female = true
+	}
+
+	birthDate, ok := makeDate(2000+year, month, day)
+	if !ok || birthDate.After(now) {
+		birthDate, ok = makeDate(1900+year, month, day)
+	}
+
+	if !ok {
+		return NIKInfo{}, goval.NewRuleError(CodeNIKBirthDate)
+	}
+	info.BirthDate = birthDate
+	return info, nil
+}
+
+// NIK ensures that the string is a valid NIK, see ParseNIK.
+func NIK() goval.StringValidator {
+	return func(ctx context.Context, value string) error {
+		_, err := ParseNIK(value)
+		return err
+	}
+}
+
+// makeDate returns the date in UTC and reports whether it is a valid calendar date.
+func makeDate(year, month, day int) (time.Time, bool) {
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	return t, t.Year() == year && t.Month() == time.Month(month) && t.Day() == day
+}
@@ -0,0 +1,47 @@
+package govalid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg-id/goval/govalid"
+	"github.com/pkg-id/goval/internal/ruletest"
+)
+
+func TestParseNIK(t *testing.T) {
+	info, err := govalid.ParseNIK("3201015205900001")
+	if err != nil {
+		t.Fatalf("expect no error; got %v", err)
+	}
+
+	if info.Province != "32" || info.Regency != "3201" || info.District != "320101" || info.Serial != "0001" {
+		t.Errorf("unexpected codes: %+v", info)
+	}
+
+	if !info.Female || !info.BirthDate.Equal(time.Date(1990, 5, 12, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expect a woman born on 1990-05-12; got %+v", info)
+	}
+
+	if info.ProvinceName() != "Jawa Barat" {
+		t.Errorf("expect Jawa Barat; got %q", info.ProvinceName())
+	}
+}
+
+func TestNIK(t *testing.T) {
+	ruletest.Run(t, govalid.NIK(), []ruletest.Case{
+		{Desc: "ok", Input: "3201011205900001"},
+		{Desc: "female", Input: "3201015205900001"},
+		{Desc: "leap day 2000", Input: "3201012902000001"},
+		{Desc: "length", Input: "320101120590001", Code: govalid.CodeNIK},
+		{Desc: "not digits", Input: "32010112059O0001", Code: govalid.CodeNIK},
+		{Desc: "zero serial", Input: "3201011205900000", Code: govalid.CodeNIK},
+		{Desc: "unknown province", Input: "2001011205900001", Code: govalid.CodeNIKRegion, Args: []any{"20"}},
+		{Desc: "zero regency", Input: "3200011205900001", Code: govalid.CodeNIKRegion, Args: []any{"32"}},
+		{Desc: "zero district", Input: "3201001205900001", Code: govalid.CodeNIKRegion},
+		{Desc: "day", Input: "3201013202900001", Code: govalid.CodeNIKBirthDate},
+		{Desc: "female day", Input: "3201017202900001", Code: govalid.CodeNIKBirthDate},
+		{Desc: "month", Input: "3201011213900001", Code: govalid.CodeNIKBirthDate},
+		{Desc: "february 30", Input: "3201013002900001", Code: govalid.CodeNIKBirthDate},
+		{Desc: "not a leap year", Input: "3201012902010001", Code: govalid.CodeNIKBirthDate},
+	})
+}
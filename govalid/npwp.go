@@ -0,0 +1,42 @@
+package govalid
+
+import (
+	"context"
+
+	"github.com/pkg-id/goval"
+	"github.com/pkg-id/goval/internal/digits"
+)
+
+// NPWP ensures that the string is an NPWP (Nomor Pokok Wajib Pajak) in either format,
+// the digits may be grouped by dots, hyphens or spaces, e.g. "01.000.013.1-093.000".
+//
+//   - The 15-digit format is an 8-digit serial number, a check digit, a 3-digit tax office code and a 3-digit branch code.
+//     The check digit is the Luhn check digit of the serial number.
+//   - The 16-digit format is the NIK for individuals, or the 15-digit format prefixed by "0" for the others.
+//
+// It returns CodeNPWP if the string is not in either format and CodeNPWPChecksum if the check digit is wrong.
+func NPWP() goval.StringValidator {
+	return func(ctx context.Context, value string) error {
+		npwp := digits.Strip(value, " .-")
+		if !digits.Valid(npwp) {
+			return goval.NewRuleError(CodeNPWP)
+		}
+
+		switch {
+		case len(npwp) == 16 && npwp[0] == '0':
+			npwp = npwp[1:]
+		case len(npwp) == 16:
+			if _, err := ParseNIK(npwp); err != nil {
+				return goval.NewRuleError(CodeNPWP)
+			}
+			return nil
+		case len(npwp) != 15:
+			return goval.NewRuleError(CodeNPWP)
+		}
+
+		if !digits.Luhn(npwp[:9]) {
+			return goval.NewRuleError(CodeNPWPChecksum)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,22 @@
+package govalid_test
+
+import (
+	"testing"
+
+	"github.com/pkg-id/goval/govalid"
+	"github.com/pkg-id/goval/internal/ruletest"
+)
+
+func TestNPWP(t *testing.T) {
+	ruletest.Run(t, govalid.NPWP(), []ruletest.Case{
+		{Desc: "15 digits formatted", Input: "01.000.013.1-093.000"},
+		{Desc: "15 digits plain", Input: "010611705093000"},
+		{Desc: "16 digits prefixed", Input: "0010000131093000"},
+		{Desc: "16 digits nik", Input: "3201011205900001"},
+		{Desc: "checksum", Input: "01.000.013.2-093.000", Code: govalid.CodeNPWPChecksum},
+		{Desc: "16 digits prefixed checksum", Input: "0010000132093000", Code: govalid.CodeNPWPChecksum},
+		{Desc: "16 digits invalid nik", Input: "3201011299900001", Code: govalid.CodeNPWP},
+		{Desc: "length", Input: "01.000.013.1-093", Code: govalid.CodeNPWP},
+		{Desc: "letters", Input: "01.000.013.1-093.00A", Code: govalid.CodeNPWP},
+	})
+}
@@ -0,0 +1,18 @@
+package govalid
+
+import (
+	"context"
+
+	"github.com/pkg-id/goval"
+	"github.com/pkg-id/goval/internal/digits"
+)
+
+// PostalCode ensures that the string is an Indonesian postal code, 5 digits from 10110 to 99999.
+func PostalCode() goval.StringValidator {
+	return func(ctx context.Context, value string) error {
+		if len(value) != 5 || !digits.Valid(value) || value < "10110" {
+			return goval.NewRuleError(CodePostalCode)
+		}
+		return nil
+	}
+}
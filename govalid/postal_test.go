@@ -0,0 +1,18 @@
+package govalid_test
+
+import (
+	"testing"
+
+	"github.com/pkg-id/goval/govalid"
+	"github.com/pkg-id/goval/internal/ruletest"
+)
+
+func TestPostalCode(t *testing.T) {
+	ruletest.Run(t, govalid.PostalCode(), []ruletest.Case{
+		{Desc: "jakarta", Input: "10110"},
+		{Desc: "papua", Input: "99976"},
+		{Desc: "below range", Input: "01234", Code: govalid.CodePostalCode},
+		{Desc: "length", Input: "4011", Code: govalid.CodePostalCode},
+		{Desc: "letters", Input: "4011A", Code: govalid.CodePostalCode},
+	})
+}
@@ -0,0 +1,96 @@
+package govaliso
+
+import (
+	"context"
+	_ "embed"
+	"strings"
+	"sync"
+
+	"github.com/pkg-id/goval"
+)
+
+//go:embed countries.tsv
+var countriesFile string
+
+// CountryInfo is an ISO 3166-1 country.
+type CountryInfo struct {
+	Alpha2  string // e.g. "ID".
+	Alpha3  string // e.g. "IDN".
+	Numeric string // the 3-digit code, e.g. "360".
+}
+
+// countrySets indexes the countries by each of their codes.
+type countrySets struct {
+	byAlpha2  map[string]CountryInfo
+	byAlpha3  map[string]CountryInfo
+	byNumeric map[string]CountryInfo
+}
+
+var countries countrySets
+var countriesOnce sync.Once
+
+// getCountries parses the embedded countries once.
+func getCountries() *countrySets {
+	countriesOnce.Do(func() {
+		countries = countrySets{
+			byAlpha2:  make(map[string]CountryInfo),
+			byAlpha3:  make(map[string]CountryInfo),
+			byNumeric: make(map[string]CountryInfo),
+		}
+
+		scanLines(countriesFile, func(line string) {
+			fields := strings.Split(line, "\t")
+			c := CountryInfo{Alpha2: fields[0], Alpha3: fields[1], Numeric: fields[2]}
+			countries.byAlpha2[c.Alpha2] = c
+			countries.byAlpha3[c.Alpha3] = c
+			countries.byNumeric[c.Numeric] = c
+		})
+	})
+	return &countries
+}
+
+// LookupCountry returns the country of the alpha-2, alpha-3 or numeric code, the letters must be uppercase.
+func LookupCountry(code string) (CountryInfo, bool) {
+	sets := getCountries()
+	var c CountryInfo
+	var ok bool
+	switch len(code) {
+	case 2:
+		c, ok = sets.byAlpha2[code]
+	case 3:
+		if c, ok = sets.byAlpha3[code]; !ok {
+			c, ok = sets.byNumeric[code]
+		}
+	}
+	return c, ok
+}
+
+// CountryAlpha2 ensures that the string is an ISO 3166-1 alpha-2 code in uppercase, e.g. "ID".
+func CountryAlpha2() goval.StringValidator {
+	return func(ctx context.Context, value string) error {
+		if _, ok := getCountries().byAlpha2[value]; !ok {
+			return goval.NewRuleError(CodeCountryAlpha2)
+		}
+		return nil
+	}
+}
+
+// CountryAlpha3 ensures that the string is an ISO 3166-1 alpha-3 code in uppercase, e.g. "IDN".
+func CountryAlpha3() goval.StringValidator {
+	return func(ctx context.Context, value string) error {
+		if _, ok := getCountries().byAlpha3[value]; !ok {
+			return goval.NewRuleError(CodeCountryAlpha3)
+		}
+		return nil
+	}
+}
+
+// CountryNumeric ensures that the string is an ISO 3166-1 numeric code of 3 digits, e.g. "360".
+func CountryNumeric() goval.StringValidator {
+	return func(ctx context.Context, value string) error {
+		if _, ok := getCountries().byNumeric[value]; !ok {
+			return goval.NewRuleError(CodeCountryNumeric)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,51 @@
+package govaliso_test
+
+import (
+	"testing"
+
+	"github.com/pkg-id/goval/govaliso"
+	"github.com/pkg-id/goval/internal/ruletest"
+)
+
+func TestCountryAlpha2(t *testing.T) {
+	ruletest.Run(t, govaliso.CountryAlpha2(), []ruletest.Case{
+		{Desc: "indonesia", Input: "ID"},
+		{Desc: "united states", Input: "US"},
+		{Desc: "lowercase", Input: "id", Code: govaliso.CodeCountryAlpha2},
+		{Desc: "unassigned", Input: "ZZ", Code: govaliso.CodeCountryAlpha2},
+		{Desc: "alpha-3", Input: "IDN", Code: govaliso.CodeCountryAlpha2},
+		{Desc: "empty", Input: "", Code: govaliso.CodeCountryAlpha2},
+	})
+}
+
+func TestCountryAlpha3(t *testing.T) {
+	ruletest.Run(t, govaliso.CountryAlpha3(), []ruletest.Case{
+		{Desc: "indonesia", Input: "IDN"},
+		{Desc: "lowercase", Input: "idn", Code: govaliso.CodeCountryAlpha3},
+		{Desc: "alpha-2", Input: "ID", Code: govaliso.CodeCountryAlpha3},
+		{Desc: "unassigned", Input: "XYZ", Code: govaliso.CodeCountryAlpha3},
+	})
+}
+
+func TestCountryNumeric(t *testing.T) {
+	ruletest.Run(t, govaliso.CountryNumeric(), []ruletest.Case{
+		{Desc: "indonesia", Input: "360"},
+		{Desc: "afghanistan leading zero", Input: "004"},
+		{Desc: "without leading zero", Input: "4", Code: govaliso.CodeCountryNumeric},
+		{Desc: "unassigned", Input: "999", Code: govaliso.CodeCountryNumeric},
+	})
+}
+
+func TestLookupCountry(t *testing.T) {
+	want := govaliso.CountryInfo{Alpha2: "ID", Alpha3: "IDN", Numeric: "360"}
+	for _, code := range []string{"ID", "IDN", "360"} {
+		got, ok := govaliso.LookupCountry(code)
+		if !ok || got != want {
+			t.Errorf("LookupCountry(%q) = %v, %v; expect %v", code, got, ok, want)
+		}
+	}
+
+	if _, ok := govaliso.LookupCountry("id"); ok {
+		t.Errorf("LookupCountry(%q) expect not found", "id")
+	}
+}
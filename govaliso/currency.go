@@ -0,0 +1,65 @@
+package govaliso
+
+import (
+	"context"
+	_ "embed"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg-id/goval"
+)
+
+//go:embed currencies.tsv
+var currenciesFile string
+
+// CurrencyInfo is an ISO 4217 currency.
+type CurrencyInfo struct {
+	Code       string // the alpha-3 code, e.g. "IDR".
+	Numeric    string // the 3-digit code, e.g. "360".
+	MinorUnits int    // the number of digits after the decimal separator, -1 if not applicable, e.g. for "XAU".
+}
+
+var currencies map[string]CurrencyInfo
+var currenciesOnce sync.Once
+
+// getCurrencies parses the embedded currencies once.
+func getCurrencies() map[string]CurrencyInfo {
+	currenciesOnce.Do(func() {
+		currencies = make(map[string]CurrencyInfo)
+		scanLines(currenciesFile, func(line string) {
+			fields := strings.Split(line, "\t")
+			minorUnits, _ := strconv.Atoi(fields[2])
+			currencies[fields[0]] = CurrencyInfo{Code: fields[0], Numeric: fields[1], MinorUnits: minorUnits}
+		})
+	})
+	return currencies
+}
+
+// LookupCurrency returns the currency of the alpha-3 code in uppercase, e.g. "IDR".
+func LookupCurrency(code string) (CurrencyInfo, bool) {
+	c, ok := getCurrencies()[code]
+	return c, ok
+}
+
+// CurrencyMinorUnits returns the minor units of the currency of the alpha-3 code in uppercase, that is,
+// the number of digits after the decimal separator, e.g. 2 for "IDR" and 0 for "JPY",
+// or -1 if not applicable, e.g. for "XAU". It returns false if the code is not a currency.
+func CurrencyMinorUnits(code string) (int, bool) {
+	c, ok := getCurrencies()[code]
+	if !ok {
+		return 0, false
+	}
+	return c.MinorUnits, true
+}
+
+// Currency ensures that the string is an active ISO 4217 currency code in uppercase, e.g. "IDR".
+// Use CurrencyMinorUnits to get its minor units, e.g. to validate the scale of an amount.
+func Currency() goval.StringValidator {
+	return func(ctx context.Context, value string) error {
+		if _, ok := getCurrencies()[value]; !ok {
+			return goval.NewRuleError(CodeCurrency)
+		}
+		return nil
+	}
+}
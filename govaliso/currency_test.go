@@ -0,0 +1,63 @@
+package govaliso_test
+
+import (
+	"testing"
+
+	"github.com/pkg-id/goval/govaliso"
+	"github.com/pkg-id/goval/internal/ruletest"
+)
+
+func TestCurrency(t *testing.T) {
+	ruletest.Run(t, govaliso.Currency(), []ruletest.Case{
+		{Desc: "rupiah", Input: "IDR"},
+		{Desc: "euro", Input: "EUR"},
+		{Desc: "gold", Input: "XAU"},
+		{Desc: "lowercase", Input: "idr", Code: govaliso.CodeCurrency},
+		{Desc: "unknown", Input: "ABC", Code: govaliso.CodeCurrency},
+		{Desc: "empty", Input: "", Code: govaliso.CodeCurrency},
+	})
+}
+
+func TestLookupCurrency(t *testing.T) {
+	tests := []struct {
+		code       string
+		numeric    string
+		minorUnits int
+	}{
+		{code: "IDR", numeric: "360", minorUnits: 2},
+		{code: "JPY", numeric: "392", minorUnits: 0},
+		{code: "KWD", numeric: "414", minorUnits: 3},
+		{code: "CLF", numeric: "990", minorUnits: 4},
+		{code: "XAU", numeric: "959", minorUnits: -1},
+	}
+
+	for _, tc := range tests {
+		got, ok := govaliso.LookupCurrency(tc.code)
+		want := govaliso.CurrencyInfo{Code: tc.code, Numeric: tc.numeric, MinorUnits: tc.minorUnits}
+		if !ok || got != want {
+			t.Errorf("LookupCurrency(%q) = %v, %v; expect %v", tc.code, got, ok, want)
+		}
+	}
+}
+
+func TestCurrencyMinorUnits(t *testing.T) {
+	tests := []struct {
+		code       string
+		minorUnits int
+		ok         bool
+	}{
+		{code: "IDR", minorUnits: 2, ok: true},
+		{code: "JPY", minorUnits: 0, ok: true},
+		{code: "KWD", minorUnits: 3, ok: true},
+		{code: "XAU", minorUnits: -1, ok: true},
+		{code: "idr"},
+		{code: "ABC"},
+	}
+
+	for _, tc := range tests {
+		minorUnits, ok := govaliso.CurrencyMinorUnits(tc.code)
+		if minorUnits != tc.minorUnits || ok != tc.ok {
+			t.Errorf("CurrencyMinorUnits(%q) = %d, %v; expect %d, %v", tc.code, minorUnits, ok, tc.minorUnits, tc.ok)
+		}
+	}
+}
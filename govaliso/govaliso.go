@@ -0,0 +1,50 @@
+// Package govaliso provides the rules for the ISO code sets: the ISO 3166-1 countries, the ISO 4217 currencies,
+// the BCP 47 language tags with the ISO 639 languages, and the IANA time zones.
+//
+// The code sets are embedded, see DatasetVersion, and the time zones are checked against the time zone database
+// that is embedded by the time/tzdata package, so the rules do not depend on the zoneinfo files of the OS.
+package govaliso
+
+import (
+	"bufio"
+	"strings"
+
+	"github.com/pkg-id/goval"
+	"github.com/pkg-id/goval/internal/rulecode"
+)
+
+// DatasetVersion is the release of the iso-codes project that the embedded code sets are taken from.
+const DatasetVersion = "iso-codes 4.15.0"
+
+const (
+	CodeCountryAlpha2  = rulecode.Code("govaliso.country_alpha2")
+	CodeCountryAlpha3  = rulecode.Code("govaliso.country_alpha3")
+	CodeCountryNumeric = rulecode.Code("govaliso.country_numeric")
+	CodeCurrency       = rulecode.Code("govaliso.currency")
+	CodeLanguageTag    = rulecode.Code("govaliso.language_tag")
+	CodeLanguage       = rulecode.Code("govaliso.language")
+	CodeTimeZone       = rulecode.Code("govaliso.time_zone")
+)
+
+func init() {
+	goval.MustRegisterRuleCode(
+		rulecode.Info(CodeCountryAlpha2),
+		rulecode.Info(CodeCountryAlpha3),
+		rulecode.Info(CodeCountryNumeric),
+		rulecode.Info(CodeCurrency),
+		rulecode.Info(CodeLanguageTag),
+		rulecode.Info(CodeLanguage, "language"),
+		rulecode.Info(CodeTimeZone),
+	)
+}
+
+// scanLines calls fn for each line of the embedded file, except the empty lines and the "#" comments.
+func scanLines(file string, fn func(line string)) {
+	scanner := bufio.NewScanner(strings.NewReader(file))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line != "" && !strings.HasPrefix(line, "#") {
+			fn(line)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package govaliso
+
+import (
+	"context"
+	_ "embed"
+	"strings"
+	"sync"
+
+	"github.com/pkg-id/goval"
+)
+
+//go:embed languages.txt
+var languagesFile string
+
+var languages map[string]struct{}
+var languagesOnce sync.Once
+
+// grandfathered are the tags of RFC 5646 that are well-formed only by their registration, in lowercase.
+var grandfathered = map[string]struct{}{
+	"en-gb-oed": {}, "i-ami": {}, "i-bnn": {}, "i-default": {}, "i-enochian": {}, "i-hak": {}, "i-klingon": {},
+	"i-lux": {}, "i-mingo": {}, "i-navajo": {}, "i-pwn": {}, "i-tao": {}, "i-tay": {}, "i-tsu": {},
+	"sgn-be-fr": {}, "sgn-be-nl": {}, "sgn-ch-de": {},
+	"art-lojban": {}, "cel-gaulish": {}, "no-bok": {}, "no-nyn": {},
+	"zh-guoyu": {}, "zh-hakka": {}, "zh-min": {}, "zh-min-nan": {}, "zh-xiang": {},
+}
+
+// isLanguage reports whether the lowercase code is an ISO 639 language, including the range "qaa" to "qtz" for local use.
+func isLanguage(code string) bool {
+	languagesOnce.Do(func() {
+		languages = make(map[string]struct{})
+		scanLines(languagesFile, func(line string) {
+			for _, code := range strings.Fields(line) {
+				languages[code] = struct{}{}
+			}
+		})
+	})
+
+	if _, ok := languages[code]; ok {
+		return true
+	}
+	return len(code) == 3 && code >= "qaa" && code <= "qtz"
+}
+
+// LanguageTag ensures that the string is a well-formed BCP 47 language tag, see RFC 5646 section 2.1,
+// and its primary and extended language subtags are ISO 639 languages, e.g. "id", "en-US" or "zh-Hant-TW".
+// The subtags are case-insensitive, the grandfathered tags and the private use tags, e.g. "x-klingon", are accepted.
+//
+// It returns CodeLanguageTag if the tag is not well-formed and CodeLanguage with the subtag if the language is unknown.
+func LanguageTag() goval.StringValidator {
+	return func(ctx context.Context, value string) error {
+		tag := strings.ToLower(value)
+		if _, ok := grandfathered[tag]; ok {
+			return nil
+		}
+
+		languages, ok := parseLanguageTag(strings.Split(tag, "-"))
+		if !ok {
+			return goval.NewRuleError(CodeLanguageTag)
+		}
+
+		for _, language := range languages {
+			if !isLanguage(language) {
+				return goval.NewRuleError(CodeLanguage, language)
+			}
+		}
+		return nil
+	}
+}
+
+// parseLanguageTag checks the lowercase subtags against the langtag and privateuse productions of RFC 5646,
+// and returns the primary and the extended language subtags.
+func parseLanguageTag(subtags []string) ([]string, bool) {
+	for _, s := range subtags {
+		if s == "" || len(s) > 8 || !isAlnum(s) {
+			return nil, false
+		}
+	}
+
+	i := 0
+	next := func(ok func(s string) bool) bool {
+		if i < len(subtags) && ok(subtags[i]) {
+			i++
+			return true
+		}
+		return false
+	}
+
+	if subtags[0] == "x" {
+		return nil, isPrivateUse(subtags)
+	}
+
+	// language = 2*3ALPHA ["-" extlang] / 4ALPHA / 5*8ALPHA, extlang = 3ALPHA *2("-" 3ALPHA)
+	languages := []string{subtags[0]}
+	if !next(func(s string) bool { return len(s) >= 2 && isAlpha(s) }) {
+		return nil, false
+	}
+
+	if len(languages[0]) <= 3 {
+		for n := 0; n < 3 && next(func(s string) bool { return len(s) == 3 && isAlpha(s) }); n++ {
+			languages = append(languages, subtags[i-1])
+		}
+	}
+
+	next(func(s string) bool { return len(s) == 4 && isAlpha(s) })                               // script
+	next(func(s string) bool { return len(s) == 2 && isAlpha(s) || len(s) == 3 && isDigits(s) }) // region
+	for next(isVariant) {
+	}
+
+	for i < len(subtags) && len(subtags[i]) == 1 && subtags[i] != "x" {
+		i++
+		if !next(func(s string) bool { return len(s) >= 2 }) {
+			return nil, false
+		}
+		for next(func(s string) bool { return len(s) >= 2 }) {
+		}
+	}
+
+	if i < len(subtags) && !isPrivateUse(subtags[i:]) {
+		return nil, false
+	}
+	return languages, true
+}
+
+// isVariant reports whether the subtag is a variant, 5*8alphanum / (DIGIT 3alphanum).
+func isVariant(s string) bool {
+	return len(s) >= 5 || len(s) == 4 && s[0] >= '0' && s[0] <= '9'
+}
+
+// isPrivateUse reports whether the subtags are "x" followed by at least one subtag.
+func isPrivateUse(subtags []string) bool {
+	return len(subtags) >= 2 && subtags[0] == "x"
+}
+
+// isAlpha reports whether s only contains ASCII lowercase letters.
+func isAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'a' || s[i] > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// isDigits reports whether s only contains ASCII digits.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isAlnum reports whether s only contains ASCII lowercase letters and digits.
+func isAlnum(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if (s[i] < 'a' || s[i] > 'z') && (s[i] < '0' || s[i] > '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,39 @@
+package govaliso_test
+
+import (
+	"testing"
+
+	"github.com/pkg-id/goval/govaliso"
+	"github.com/pkg-id/goval/internal/ruletest"
+)
+
+func TestLanguageTag(t *testing.T) {
+	ruletest.Run(t, govaliso.LanguageTag(), []ruletest.Case{
+		{Desc: "language", Input: "id"},
+		{Desc: "language and region", Input: "en-US"},
+		{Desc: "case-insensitive", Input: "EN-us"},
+		{Desc: "script and region", Input: "zh-Hant-TW"},
+		{Desc: "numeric region", Input: "es-419"},
+		{Desc: "iso 639-3", Input: "jav"},
+		{Desc: "extlang", Input: "zh-yue-HK"},
+		{Desc: "variant", Input: "sl-rozaj-biske"},
+		{Desc: "digit variant", Input: "de-CH-1901"},
+		{Desc: "extension", Input: "en-US-u-ca-gregory"},
+		{Desc: "private use suffix", Input: "en-x-internal"},
+		{Desc: "private use only", Input: "x-klingon"},
+		{Desc: "local use range", Input: "qaa"},
+		{Desc: "grandfathered", Input: "i-klingon"},
+		{Desc: "empty", Input: "", Code: govaliso.CodeLanguageTag},
+		{Desc: "underscore", Input: "en_US", Code: govaliso.CodeLanguageTag},
+		{Desc: "empty subtag", Input: "en--US", Code: govaliso.CodeLanguageTag},
+		{Desc: "trailing hyphen", Input: "en-", Code: govaliso.CodeLanguageTag},
+		{Desc: "one letter language", Input: "e", Code: govaliso.CodeLanguageTag},
+		{Desc: "subtag too long", Input: "en-abcdefghi", Code: govaliso.CodeLanguageTag},
+		{Desc: "extension without subtag", Input: "en-u", Code: govaliso.CodeLanguageTag},
+		{Desc: "region before script", Input: "zh-TW-Hant", Code: govaliso.CodeLanguageTag},
+		{Desc: "private use without subtag", Input: "en-x", Code: govaliso.CodeLanguageTag},
+		{Desc: "unknown language", Input: "zz-US", Code: govaliso.CodeLanguage, Args: []any{"zz"}},
+		{Desc: "unknown extlang", Input: "zh-zzz", Code: govaliso.CodeLanguage, Args: []any{"zzz"}},
+		{Desc: "reserved long language", Input: "abcde", Code: govaliso.CodeLanguage, Args: []any{"abcde"}},
+	})
+}
@@ -0,0 +1,70 @@
+package govaliso
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+	_ "time/tzdata" // embeds the time zone database, so the OS zoneinfo is not needed.
+
+	"github.com/pkg-id/goval"
+)
+
+// timeZones caches the names that are loaded by time.LoadLocation, so it is bounded by the time zone database.
+var timeZones sync.Map
+
+// TimeZone ensures that the string is an IANA time zone name, e.g. "Asia/Jakarta" or "UTC".
+// The name is case-sensitive, and "Local" and "Factory" are rejected since they are not locations.
+//
+// The name must have the shape of an IANA name, see isTimeZoneName, then it is checked by time.LoadLocation.
+// This package imports time/tzdata, so the name is checked against the database embedded in the program
+// when the OS has no zoneinfo. Only the valid names are cached.
+func TimeZone() goval.StringValidator {
+	return func(ctx context.Context, value string) error {
+		if !isTimeZone(value) {
+			return goval.NewRuleError(CodeTimeZone)
+		}
+		return nil
+	}
+}
+
+// isTimeZone reports whether the name is an IANA name that is loadable as a time zone.
+func isTimeZone(name string) bool {
+	if !isTimeZoneName(name) || name == "Local" || name == "Factory" {
+		return false
+	}
+
+	if _, cached := timeZones.Load(name); cached {
+		return true
+	}
+
+	if _, err := time.LoadLocation(name); err != nil {
+		return false
+	}
+	timeZones.Store(name, struct{}{})
+	return true
+}
+
+// isTimeZoneName reports whether the name has the shape of an IANA name, e.g. "America/Argentina/Buenos_Aires"
+// or "Etc/GMT+7", that is, slash-separated parts of at most 14 ASCII letters, digits, "-", "_" and "+",
+// each starting with an uppercase letter. It excludes the paths and the files of the zoneinfo directory
+// that are not time zones, such as "posixrules" and "right/UTC".
+func isTimeZoneName(name string) bool {
+	if name == "" || len(name) > 64 {
+		return false
+	}
+
+	for _, part := range strings.Split(name, "/") {
+		if part == "" || len(part) > 14 || part[0] < 'A' || part[0] > 'Z' {
+			return false
+		}
+
+		for i := 1; i < len(part); i++ {
+			c := part[i]
+			if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-' || c == '_' || c == '+') {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,32 @@
+package govaliso_test
+
+import (
+	"testing"
+
+	"github.com/pkg-id/goval/govaliso"
+	"github.com/pkg-id/goval/internal/ruletest"
+)
+
+func TestTimeZone(t *testing.T) {
+	validator := govaliso.TimeZone()
+	tests := []ruletest.Case{
+		{Desc: "jakarta", Input: "Asia/Jakarta"},
+		{Desc: "utc", Input: "UTC"},
+		{Desc: "three levels", Input: "America/Argentina/Buenos_Aires"},
+		{Desc: "wrong case", Input: "asia/jakarta", Code: govaliso.CodeTimeZone},
+		{Desc: "unknown", Input: "Asia/Bandung", Code: govaliso.CodeTimeZone},
+		{Desc: "local", Input: "Local", Code: govaliso.CodeTimeZone},
+		{Desc: "empty", Input: "", Code: govaliso.CodeTimeZone},
+		{Desc: "path traversal", Input: "../etc/passwd", Code: govaliso.CodeTimeZone},
+		{Desc: "absolute path", Input: "/etc/localtime", Code: govaliso.CodeTimeZone},
+		{Desc: "link", Input: "US/Eastern"},
+		{Desc: "offset", Input: "Etc/GMT+7"},
+		{Desc: "trailing slash", Input: "Asia/Jakarta/", Code: govaliso.CodeTimeZone},
+		{Desc: "space", Input: "Asia/Jakarta ", Code: govaliso.CodeTimeZone},
+		{Desc: "long part", Input: "Asia/JakartaJakartaJakarta", Code: govaliso.CodeTimeZone},
+		{Desc: "posix rules file", Input: "posixrules", Code: govaliso.CodeTimeZone},
+		{Desc: "leap second zone", Input: "right/UTC", Code: govaliso.CodeTimeZone},
+		{Desc: "factory placeholder", Input: "Factory", Code: govaliso.CodeTimeZone},
+	}
+	ruletest.Run(t, validator, tests)
+}
@@ -0,0 +1,38 @@
+// Package govaljwt provides the structural rules for the JSON Web Tokens, see RFC 7519. The header and the payload
+// are decoded and checked, but the signature is not verified, so the rules are meant to reject the malformed,
+// expired or foreign tokens cheaply before the token reaches the service that verifies it.
+//
+//	goval.Named("token", bearer, govaljwt.JWT(govaljwt.Options{
+//		Algorithms:     []string{"RS256"},
+//		RequiredClaims: []string{"sub", "exp"},
+//		Issuer:         goval.String().In("https://auth.example.com"),
+//		Audience:       goval.String().In("api"),
+//	}))
+//
+// A token without the "iss" or "aud" claim is rejected when its validator is set.
+package govaljwt
+
+import (
+	"github.com/pkg-id/goval"
+	"github.com/pkg-id/goval/internal/rulecode"
+)
+
+const (
+	CodeToken         = rulecode.Code("govaljwt.token")
+	CodeHeader        = rulecode.Code("govaljwt.header")
+	CodePayload       = rulecode.Code("govaljwt.payload")
+	CodeAlgorithm     = rulecode.Code("govaljwt.algorithm")
+	CodeClaimRequired = rulecode.Code("govaljwt.claim_required")
+	CodeClaimType     = rulecode.Code("govaljwt.claim_type")
+)
+
+func init() {
+	goval.MustRegisterRuleCode(
+		rulecode.Info(CodeToken),
+		rulecode.Info(CodeHeader),
+		rulecode.Info(CodePayload),
+		rulecode.Info(CodeAlgorithm, "alg", "algorithms"),
+		rulecode.Info(CodeClaimRequired),
+		rulecode.Info(CodeClaimType, "type"),
+	)
+}
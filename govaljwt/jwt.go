@@ -0,0 +1,248 @@
+package govaljwt
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg-id/goval"
+	"github.com/pkg-id/goval/funcs"
+)
+
+// Token is a decoded JSON Web Token in the JWS compact serialization, its signature is not verified.
+type Token struct {
+	Header    map[string]any // the JOSE header, decoded by json.Unmarshal.
+	Claims    map[string]any // the claims of the payload, decoded by json.Unmarshal, so the numbers are float64.
+	Signature []byte
+}
+
+// Parse decodes the token, which is three base64url segments without padding separated by dots,
+// where the header and the payload are JSON objects and the signature is not empty.
+//
+// It returns a goval.RuleError with CodeToken if the token does not have the three segments or the signature
+// is malformed, CodeHeader if the header is malformed, and CodePayload if the payload is malformed.
+func Parse(token string) (Token, error) {
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return Token{}, goval.NewRuleError(CodeToken)
+	}
+
+	var t Token
+	if !decodeSegment(segments[0], &t.Header) {
+		return Token{}, goval.NewRuleError(CodeHeader)
+	}
+
+	if !decodeSegment(segments[1], &t.Claims) {
+		return Token{}, goval.NewRuleError(CodePayload)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(segments[2])
+	if err != nil || len(signature) == 0 {
+		return Token{}, goval.NewRuleError(CodeToken)
+	}
+	t.Signature = signature
+	return t, nil
+}
+
+// Options is the policy of the JWT rule.
+type Options struct {
+	// Algorithms are the allowed values of the "alg" header, compared case-sensitively, e.g. "RS256".
+	// Empty allows any algorithm. The unsecured "none" is always rejected, in any case.
+	Algorithms []string
+	// Clock returns the current time to check the time claims against, nil uses time.Now.
+	Clock func() time.Time
+	// Leeway is the tolerated clock skew for the time claims.
+	Leeway time.Duration
+	// RequiredClaims are the claims that must be present, e.g. "sub" and "exp".
+	RequiredClaims []string
+	// Issuer validates the "iss" claim, which is required if the Issuer is set. Nil skips the check.
+	Issuer goval.StringValidator
+	// Audience validates the "aud" claim, which is either a string or an array of strings, and is required
+	// if the Audience is set. The claim is valid if any of its audiences is valid, an empty array is validated
+	// as the empty audience "". Nil skips the check.
+	Audience goval.StringValidator
+	// Claims validate the other claims by their names, if present. The values are decoded by json.Unmarshal,
+	// as in goval.SVV.JSON.
+	Claims map[string]goval.RuleValidator[any]
+}
+
+// JWT ensures that the string is a JSON Web Token, see Parse, that satisfies the options.
+//
+// Besides the errors of Parse, it returns CodeAlgorithm with the algorithm and the allowed algorithms
+// if the algorithm is not allowed. Otherwise, the claims are validated and each error is a goval.KeyError
+// keyed by the claim name, collected into goval.Errors:
+//   - CodeClaimRequired if a required claim is missing, or if "iss" or "aud" is missing while its validator is set,
+//   - CodeClaimType with the expected JSON type if a registered claim has a wrong type,
+//   - goval.TimeMin if "exp" is not after the clock minus the leeway, as the token must not be accepted
+//     on or after its expiration time, see RFC 7519 section 4.1.4,
+//   - goval.TimeMax if "nbf" or "iat" is after the clock plus the leeway,
+//   - and the errors of the Issuer, Audience and Claims validators.
+func JWT(opts Options) goval.StringValidator {
+	return func(ctx context.Context, value string) error {
+		t, err := Parse(value)
+		if err != nil {
+			return err
+		}
+
+		alg, _ := t.Header["alg"].(string)
+		if strings.EqualFold(alg, "none") || alg == "" ||
+			len(opts.Algorithms) > 0 && !funcs.Contains(opts.Algorithms, func(a string) bool { return a == alg }) {
+			return goval.NewRuleError(CodeAlgorithm, alg, opts.Algorithms)
+		}
+
+		now := time.Now()
+		if opts.Clock != nil {
+			now = opts.Clock()
+		}
+		return goval.Execute(ctx, claimValidators(t.Claims, now, opts)...)
+	}
+}
+
+// claimValidators returns the validators of the claims, see JWT.
+func claimValidators(claims map[string]any, now time.Time, opts Options) []goval.Validator {
+	var validators []goval.Validator
+	for _, name := range opts.RequiredClaims {
+		if _, ok := claims[name]; !ok {
+			validators = append(validators, failed(name, goval.NewRuleError(CodeClaimRequired)))
+		}
+	}
+
+	for _, tc := range []struct {
+		name      string
+		validator goval.TimeValidator
+	}{
+		{"exp", expiration(now.Add(-opts.Leeway))},
+		{"nbf", goval.Time().Max(now.Add(opts.Leeway))},
+		{"iat", goval.Time().Max(now.Add(opts.Leeway))},
+	} {
+		if v, ok := claims[tc.name]; ok {
+			date, ok := numericDate(v)
+			if !ok {
+				validators = append(validators, failed(tc.name, goval.NewRuleError(CodeClaimType, "number")))
+				continue
+			}
+			validators = append(validators, goval.Named(tc.name, date, tc.validator))
+		}
+	}
+
+	// the missing "iss" and "aud" are already reported if they are in the RequiredClaims.
+	required := func(name string) bool {
+		return funcs.Contains(opts.RequiredClaims, func(c string) bool { return c == name })
+	}
+
+	if v, ok := claims["iss"]; !ok && opts.Issuer != nil && !required("iss") {
+		validators = append(validators, failed("iss", goval.NewRuleError(CodeClaimRequired)))
+	} else if ok && opts.Issuer != nil {
+		if iss, ok := v.(string); ok {
+			validators = append(validators, goval.Named("iss", iss, opts.Issuer))
+		} else {
+			validators = append(validators, failed("iss", goval.NewRuleError(CodeClaimType, "string")))
+		}
+	}
+
+	if v, ok := claims["aud"]; !ok && opts.Audience != nil && !required("aud") {
+		validators = append(validators, failed("aud", goval.NewRuleError(CodeClaimRequired)))
+	} else if ok && opts.Audience != nil {
+		if audiences, ok := audiencesOf(v); ok {
+			validators = append(validators, goval.Named("aud", audiences, anyAudience(opts.Audience)))
+		} else {
+			validators = append(validators, failed("aud", goval.NewRuleError(CodeClaimType, "string or array of strings")))
+		}
+	}
+
+	names := make([]string, 0, len(opts.Claims))
+	for name := range opts.Claims {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if v, ok := claims[name]; ok {
+			validators = append(validators, goval.Named(name, v, opts.Claims[name]))
+		}
+	}
+	return validators
+}
+
+// expiration returns a validator that ensures the time is strictly after min, otherwise it returns goval.TimeMin.
+func expiration(min time.Time) goval.TimeValidator {
+	return func(ctx context.Context, value time.Time) error {
+		if !value.After(min) {
+			return goval.NewRuleError(goval.TimeMin, min)
+		}
+		return nil
+	}
+}
+
+// anyAudience returns a validator that is satisfied if any of the audiences is valid,
+// otherwise it returns the error of the first audience. No audience is validated as the empty audience.
+func anyAudience(validator goval.StringValidator) goval.RuleValidator[[]string] {
+	return goval.RuleValidatorFunc[[]string](func(ctx context.Context, audiences []string) error {
+		if len(audiences) == 0 {
+			return validator.Validate(ctx, "")
+		}
+
+		var first error
+		for _, aud := range audiences {
+			err := validator.Validate(ctx, aud)
+			if err == nil {
+				return nil
+			}
+			if first == nil {
+				first = err
+			}
+		}
+		return first
+	})
+}
+
+// failed returns a validator that fails with the error keyed by the claim name.
+func failed(name string, err error) goval.Validator {
+	return goval.ValidatorFunc(func(ctx context.Context) error {
+		return goval.NewKeyError(name, err)
+	})
+}
+
+// decodeSegment decodes the base64url segment without padding as a JSON object.
+func decodeSegment(segment string, v *map[string]any) bool {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(b, v) == nil && *v != nil
+}
+
+// numericDate converts the NumericDate, the seconds since the Unix epoch, into a time.Time in UTC.
+func numericDate(v any) (time.Time, bool) {
+	f, ok := v.(float64)
+	if !ok || math.IsNaN(f) || math.Abs(f) > 1<<53 {
+		return time.Time{}, false
+	}
+
+	sec, frac := math.Modf(f)
+	return time.Unix(int64(sec), int64(frac*1e9)).UTC(), true
+}
+
+// audiencesOf returns the audiences of the "aud" claim, which is a string or an array of strings.
+func audiencesOf(v any) ([]string, bool) {
+	switch aud := v.(type) {
+	case string:
+		return []string{aud}, true
+	case []any:
+		audiences := make([]string, len(aud))
+		for i, a := range aud {
+			s, ok := a.(string)
+			if !ok {
+				return nil, false
+			}
+			audiences[i] = s
+		}
+		return audiences, true
+	default:
+		return nil, false
+	}
+}
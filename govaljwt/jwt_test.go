@@ -0,0 +1,199 @@
+package govaljwt_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/pkg-id/goval"
+	"github.com/pkg-id/goval/govaljwt"
+	"github.com/pkg-id/goval/internal/ruletest"
+)
+
+// token encodes the header and the claims into a token with a dummy signature.
+func token(header, claims map[string]any) string {
+	h, _ := json.Marshal(header)
+	c, _ := json.Marshal(claims)
+	return segment(h) + "." + segment(c) + "." + segment([]byte("signature"))
+}
+
+func segment(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func TestParse(t *testing.T) {
+	tok, err := govaljwt.Parse(token(map[string]any{"alg": "HS256", "typ": "JWT"}, map[string]any{"sub": "123", "exp": 1700000000}))
+	if err != nil {
+		t.Fatalf("expect no error; got %v", err)
+	}
+
+	want := govaljwt.Token{
+		Header:    map[string]any{"alg": "HS256", "typ": "JWT"},
+		Claims:    map[string]any{"sub": "123", "exp": float64(1700000000)},
+		Signature: []byte("signature"),
+	}
+	if !reflect.DeepEqual(tok, want) {
+		t.Errorf("expect %v; got %v", want, tok)
+	}
+}
+
+func TestJWT_Structure(t *testing.T) {
+	header := segment([]byte(`{"alg":"HS256"}`))
+	payload := segment([]byte(`{"sub":"123"}`))
+	signature := segment([]byte("signature"))
+
+	ruletest.Run(t, govaljwt.JWT(govaljwt.Options{}), []ruletest.Case{
+		{Desc: "valid", Input: header + "." + payload + "." + signature},
+		{Desc: "empty", Input: "", Code: govaljwt.CodeToken},
+		{Desc: "two segments", Input: header + "." + payload, Code: govaljwt.CodeToken},
+		{Desc: "four segments", Input: header + "." + payload + "." + signature + ".x", Code: govaljwt.CodeToken},
+		{Desc: "empty signature", Input: header + "." + payload + ".", Code: govaljwt.CodeToken},
+		{Desc: "padded signature", Input: header + "." + payload + "." + base64.URLEncoding.EncodeToString([]byte("sign")), Code: govaljwt.CodeToken},
+		{Desc: "header not base64url", Input: "e30+." + payload + "." + signature, Code: govaljwt.CodeHeader},
+		{Desc: "header not json", Input: segment([]byte("alg")) + "." + payload + "." + signature, Code: govaljwt.CodeHeader},
+		{Desc: "header null", Input: segment([]byte("null")) + "." + payload + "." + signature, Code: govaljwt.CodeHeader},
+		{Desc: "payload array", Input: header + "." + segment([]byte("[1]")) + "." + signature, Code: govaljwt.CodePayload},
+	})
+}
+
+func TestJWT_Algorithm(t *testing.T) {
+	validator := govaljwt.JWT(govaljwt.Options{Algorithms: []string{"RS256", "ES256"}})
+	claims := map[string]any{"sub": "123"}
+
+	ruletest.Run(t, validator, []ruletest.Case{
+		{Desc: "allowed", Input: token(map[string]any{"alg": "ES256"}, claims)},
+		{Desc: "not allowed", Input: token(map[string]any{"alg": "HS256"}, claims), Code: govaljwt.CodeAlgorithm, Args: []any{"HS256", []string{"RS256", "ES256"}}},
+		{Desc: "case-sensitive", Input: token(map[string]any{"alg": "rs256"}, claims), Code: govaljwt.CodeAlgorithm},
+		{Desc: "missing", Input: token(map[string]any{"typ": "JWT"}, claims), Code: govaljwt.CodeAlgorithm, Args: []any{"", []string{"RS256", "ES256"}}},
+		{Desc: "not a string", Input: token(map[string]any{"alg": 256}, claims), Code: govaljwt.CodeAlgorithm},
+	})
+
+	ruletest.Run(t, govaljwt.JWT(govaljwt.Options{Algorithms: []string{"none"}}), []ruletest.Case{
+		{Desc: "none even if allowed", Input: token(map[string]any{"alg": "none"}, claims), Code: govaljwt.CodeAlgorithm},
+	})
+
+	ruletest.Run(t, govaljwt.JWT(govaljwt.Options{}), []ruletest.Case{
+		{Desc: "any", Input: token(map[string]any{"alg": "HS512"}, claims)},
+		{Desc: "none", Input: token(map[string]any{"alg": "NoNe"}, claims), Code: govaljwt.CodeAlgorithm},
+	})
+}
+
+func TestJWT_Claims(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	header := map[string]any{"alg": "RS256"}
+	validator := govaljwt.JWT(govaljwt.Options{
+		Clock:          func() time.Time { return now },
+		Leeway:         time.Minute,
+		RequiredClaims: []string{"sub", "exp"},
+		Issuer:         goval.String().In("https://auth.example.com"),
+		Audience:       goval.String().In("api"),
+		Claims: map[string]goval.RuleValidator[any]{
+			"scope": goval.RuleValidatorFunc[any](func(ctx context.Context, v any) error {
+				if v != "read" {
+					return goval.NewRuleError(goval.StringIn, []string{"read"})
+				}
+				return nil
+			}),
+		},
+	})
+
+	valid := map[string]any{
+		"sub":   "123",
+		"iss":   "https://auth.example.com",
+		"aud":   []string{"web", "api"},
+		"exp":   now.Add(time.Hour).Unix(),
+		"nbf":   now.Unix(),
+		"iat":   now.Add(30 * time.Second).Unix(),
+		"scope": "read",
+	}
+
+	with := func(name string, value any) map[string]any {
+		claims := make(map[string]any)
+		for k, v := range valid {
+			claims[k] = v
+		}
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+		return claims
+	}
+
+	tests := []struct {
+		desc   string
+		claims map[string]any
+		key    string
+		code   goval.RuleCoder
+	}{
+		{desc: "valid", claims: valid},
+		{desc: "single audience", claims: with("aud", "api")},
+		{desc: "fractional exp", claims: with("exp", float64(now.Unix())+0.5)},
+		{desc: "exp within leeway", claims: with("exp", now.Add(-30*time.Second).Unix())},
+		{desc: "exp just after leeway", claims: with("exp", now.Add(-time.Minute+time.Second).Unix())},
+		{desc: "optional claim missing", claims: with("scope", nil)},
+		{desc: "missing sub", claims: with("sub", nil), key: "sub", code: govaljwt.CodeClaimRequired},
+		{desc: "missing exp", claims: with("exp", nil), key: "exp", code: govaljwt.CodeClaimRequired},
+		{desc: "expired", claims: with("exp", now.Add(-2*time.Minute).Unix()), key: "exp", code: goval.TimeMin},
+		{desc: "expired at leeway", claims: with("exp", now.Add(-time.Minute).Unix()), key: "exp", code: goval.TimeMin},
+		{desc: "not yet valid", claims: with("nbf", now.Add(2*time.Minute).Unix()), key: "nbf", code: goval.TimeMax},
+		{desc: "issued in the future", claims: with("iat", now.Add(time.Hour).Unix()), key: "iat", code: goval.TimeMax},
+		{desc: "exp not a number", claims: with("exp", "tomorrow"), key: "exp", code: govaljwt.CodeClaimType},
+		{desc: "foreign issuer", claims: with("iss", "https://evil.example.com"), key: "iss", code: goval.StringIn},
+		{desc: "issuer not a string", claims: with("iss", 1), key: "iss", code: govaljwt.CodeClaimType},
+		{desc: "missing issuer", claims: with("iss", nil), key: "iss", code: govaljwt.CodeClaimRequired},
+		{desc: "foreign audience", claims: with("aud", []string{"web"}), key: "aud", code: goval.StringIn},
+		{desc: "audience not strings", claims: with("aud", []any{"api", 1}), key: "aud", code: govaljwt.CodeClaimType},
+		{desc: "empty audience", claims: with("aud", []string{}), key: "aud", code: goval.StringIn},
+		{desc: "missing audience", claims: with("aud", nil), key: "aud", code: govaljwt.CodeClaimRequired},
+		{desc: "custom claim", claims: with("scope", "write"), key: "scope", code: goval.StringIn},
+	}
+
+	for _, tc := range tests {
+		err := validator.Validate(context.Background(), token(header, tc.claims))
+		if tc.code == nil {
+			if err != nil {
+				t.Errorf("%s: expect no error; got %v", tc.desc, err)
+			}
+			continue
+		}
+
+		var errs goval.Errors
+		if !errors.As(err, &errs) || len(errs) != 1 {
+			t.Errorf("%s: expect an error; got %v", tc.desc, err)
+			continue
+		}
+
+		var keyErr *goval.KeyError
+		var ruleErr *goval.RuleError
+		if !errors.As(errs[0], &keyErr) || keyErr.Key != tc.key || !errors.As(keyErr.Err, &ruleErr) || !ruleErr.Code.Equal(tc.code) {
+			t.Errorf("%s: expect %v under %q; got %v", tc.desc, tc.code, tc.key, err)
+		}
+	}
+}
+
+func TestJWT_RequiredIssuerReportedOnce(t *testing.T) {
+	validator := govaljwt.JWT(govaljwt.Options{
+		RequiredClaims: []string{"iss", "aud"},
+		Issuer:         goval.String().In("https://auth.example.com"),
+		Audience:       goval.String().In("api"),
+	})
+
+	err := validator.Validate(context.Background(), token(map[string]any{"alg": "RS256"}, map[string]any{"sub": "123"}))
+	var errs goval.Errors
+	if !errors.As(err, &errs) || len(errs) != 2 {
+		t.Fatalf("expect 2 errors; got %v", err)
+	}
+
+	for i, key := range []string{"iss", "aud"} {
+		var keyErr *goval.KeyError
+		var ruleErr *goval.RuleError
+		if !errors.As(errs[i], &keyErr) || keyErr.Key != key || !errors.As(keyErr.Err, &ruleErr) || !ruleErr.Code.Equal(govaljwt.CodeClaimRequired) {
+			t.Errorf("expect %v under %q; got %v", govaljwt.CodeClaimRequired, key, errs[i])
+		}
+	}
+}
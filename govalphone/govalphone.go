@@ -0,0 +1,32 @@
+// Package govalphone provides the country-aware phone number rules, backed by an embedded metadata table
+// of the country calling codes, the national prefixes and the lengths and patterns of the national significant numbers.
+//
+//	goval.Named("phone", req.Phone, govalphone.PhoneNumber("ID").DefaultRegion("ID").MobileOnly())
+package govalphone
+
+import (
+	"github.com/pkg-id/goval"
+	"github.com/pkg-id/goval/internal/rulecode"
+)
+
+const (
+	CodePhone       = rulecode.Code("govalphone.phone")
+	CodeCallingCode = rulecode.Code("govalphone.calling_code")
+	CodeRegion      = rulecode.Code("govalphone.region")
+	CodeCountry     = rulecode.Code("govalphone.country")
+	CodeLength      = rulecode.Code("govalphone.length")
+	CodeNumber      = rulecode.Code("govalphone.number")
+	CodeMobile      = rulecode.Code("govalphone.mobile")
+)
+
+func init() {
+	goval.MustRegisterRuleCode(
+		rulecode.Info(CodePhone),
+		rulecode.Info(CodeCallingCode, "code"),
+		rulecode.Info(CodeRegion, "region"),
+		rulecode.Info(CodeCountry, "country", "countries"),
+		rulecode.Info(CodeLength, "country", "lengths"),
+		rulecode.Info(CodeNumber, "country"),
+		rulecode.Info(CodeMobile, "country"),
+	)
+}
@@ -0,0 +1,156 @@
+package govalphone
+
+import (
+	"bufio"
+	_ "embed"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg-id/goval/funcs"
+)
+
+//go:embed metadata.tsv
+var metadataFile string
+
+// regionMetadata is the numbering plan of a region.
+type regionMetadata struct {
+	region         string
+	callingCode    int
+	nationalPrefix string
+	main           bool   // the main region of a calling code that is shared by several regions.
+	leadingDigits  string // the pattern of the leading digits that identify the region among the regions of its calling code.
+	lengths        []int  // the possible lengths of the national significant numbers.
+	pattern        string // the pattern of the national significant numbers.
+	fixedPattern   string // the pattern of the fixed-line numbers, empty if there is none.
+	mobileLengths  []int  // the possible lengths of the mobile numbers, empty is the same as lengths.
+	mobilePattern  string // the pattern of the mobile numbers, empty if there is none, "*" if it is unknown.
+
+	once            sync.Once
+	compiled        *regexp.Regexp
+	leadingCompiled *regexp.Regexp
+	fixedCompiled   *regexp.Regexp
+	mobileCompiled  *regexp.Regexp
+}
+
+// matches reports whether the national significant number matches the pattern of the region.
+func (m *regionMetadata) matches(nsn string) bool {
+	m.compile()
+	return m.compiled.MatchString(nsn)
+}
+
+// owns reports whether the national significant number belongs to the region, either by the leading digits,
+// or by the patterns of the fixed-line and the mobile numbers, the same as libphonenumber.
+func (m *regionMetadata) owns(nsn string) bool {
+	m.compile()
+	if m.leadingCompiled != nil {
+		return m.leadingCompiled.MatchString(nsn)
+	}
+
+	if m.fixedCompiled != nil && m.fixedCompiled.MatchString(nsn) {
+		return true
+	}
+	return m.mobileCompiled != nil && m.mobileCompiled.MatchString(nsn)
+}
+
+// isMobile reports whether the national significant number is a mobile number, the second result is false
+// if the region does not distinguish the mobile numbers from the fixed-line numbers.
+func (m *regionMetadata) isMobile(nsn string) (mobile bool, known bool) {
+	switch m.mobilePattern {
+	case "*":
+		return false, false
+	case "":
+		return false, true
+	}
+
+	m.compile()
+	lengths := m.mobileLengths
+	if len(lengths) == 0 {
+		lengths = m.lengths
+	}
+	return funcs.Contains(lengths, func(n int) bool { return n == len(nsn) }) && m.mobileCompiled.MatchString(nsn), true
+}
+
+// compile compiles the patterns once, they are anchored to match the whole number.
+func (m *regionMetadata) compile() {
+	m.once.Do(func() {
+		m.compiled = regexp.MustCompile("^(?:" + m.pattern + ")$")
+		if m.leadingDigits != "" {
+			m.leadingCompiled = regexp.MustCompile("^(?:" + m.leadingDigits + ")")
+		}
+		if m.fixedPattern != "" {
+			m.fixedCompiled = regexp.MustCompile("^(?:" + m.fixedPattern + ")$")
+		}
+		if m.mobilePattern != "" && m.mobilePattern != "*" {
+			m.mobileCompiled = regexp.MustCompile("^(?:" + m.mobilePattern + ")$")
+		}
+	})
+}
+
+// metadata is the parsed metadata table.
+type metadata struct {
+	byRegion      map[string]*regionMetadata
+	byCallingCode map[int][]*regionMetadata // the main region is the last one.
+}
+
+var loadedMetadata metadata
+var loadMetadataOnce sync.Once
+
+// getMetadata parses the embedded metadata table once.
+func getMetadata() *metadata {
+	loadMetadataOnce.Do(func() {
+		loadedMetadata = metadata{
+			byRegion:      make(map[string]*regionMetadata),
+			byCallingCode: make(map[int][]*regionMetadata),
+		}
+
+		scanner := bufio.NewScanner(strings.NewReader(metadataFile))
+		for scanner.Scan() {
+			line := scanner.Text()
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+
+			fields := strings.Split(line, "\t")
+			callingCode, _ := strconv.Atoi(fields[1])
+			m := &regionMetadata{
+				region:         fields[0],
+				callingCode:    callingCode,
+				nationalPrefix: fields[2],
+				main:           fields[3] == "1",
+				leadingDigits:  fields[4],
+				lengths:        parseLengths(fields[5]),
+				pattern:        fields[6],
+				fixedPattern:   fields[7],
+				mobileLengths:  parseLengths(fields[8]),
+				mobilePattern:  fields[9],
+			}
+
+			loadedMetadata.byRegion[m.region] = m
+			regions := loadedMetadata.byCallingCode[callingCode]
+			if m.main || len(regions) == 0 {
+				regions = append(regions, m)
+			} else {
+				regions = append([]*regionMetadata{m}, regions...)
+			}
+			loadedMetadata.byCallingCode[callingCode] = regions
+		}
+	})
+	return &loadedMetadata
+}
+
+// parseLengths parses the comma-separated lengths.
+func parseLengths(s string) []int {
+	if s == "" {
+		return nil
+	}
+
+	parts := strings.Split(s, ",")
+	lengths := make([]int, 0, len(parts))
+	for _, part := range parts {
+		n, _ := strconv.Atoi(part)
+		lengths = append(lengths, n)
+	}
+	return lengths
+}
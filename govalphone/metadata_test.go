@@ -0,0 +1,22 @@
+package govalphone
+
+import "testing"
+
+func TestMetadata(t *testing.T) {
+	md := getMetadata()
+	if len(md.byRegion) < 200 {
+		t.Fatalf("expect the metadata of every region; got %d regions", len(md.byRegion))
+	}
+
+	for _, m := range md.byRegion {
+		m.compile() // panics if any of the patterns is not supported by regexp.
+		if len(m.lengths) == 0 || m.callingCode == 0 {
+			t.Errorf("%s: incomplete metadata: %+v", m.region, m)
+		}
+	}
+
+	nanp := md.byCallingCode[1]
+	if main := nanp[len(nanp)-1]; main.region != "US" {
+		t.Errorf("expect US is the main region of +1; got %s", main.region)
+	}
+}
@@ -0,0 +1,185 @@
+package govalphone
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/pkg-id/goval"
+	"github.com/pkg-id/goval/funcs"
+)
+
+// Number is a parsed phone number.
+type Number struct {
+	Region         string // the ISO 3166-1 alpha-2 code of the region, e.g. "ID".
+	CallingCode    int    // the country calling code, e.g. 62.
+	NationalNumber string // the national significant number, without the national prefix, e.g. "81234567890".
+}
+
+// E164 returns the number in E.164, e.g. "+6281234567890".
+func (n Number) E164() string {
+	return "+" + strconv.Itoa(n.CallingCode) + n.NationalNumber
+}
+
+// Parse parses the phone number in the international form, prefixed by "+" or "00", or in the national form
+// of the default region, e.g. "0812-3456-7890" for "ID". The digits may be grouped by spaces, dots, hyphens,
+// slashes and parentheses. The default region may be empty if only the international form is accepted.
+//
+// It returns a goval.RuleError with CodePhone if the string is not a phone number, CodeRegion if the default region
+// is unknown, CodeCallingCode if the calling code is unknown, CodeLength with the region and its possible lengths
+// if the length of the national significant number is wrong, and CodeNumber with the region if the number
+// is not in the numbering plan of the region.
+func Parse(number, defaultRegion string) (Number, error) {
+	digits, international, ok := extractDigits(number)
+	if !ok {
+		return Number{}, goval.NewRuleError(CodePhone)
+	}
+
+	md := getMetadata()
+	var regions []*regionMetadata
+	var nsn string
+	if international {
+		callingCode, rest, ok := splitCallingCode(md, digits)
+		if !ok {
+			return Number{}, goval.NewRuleError(CodeCallingCode, digits[:minInt(3, len(digits))])
+		}
+		regions, nsn = md.byCallingCode[callingCode], rest
+	} else {
+		if defaultRegion == "" {
+			return Number{}, goval.NewRuleError(CodePhone)
+		}
+
+		region, ok := md.byRegion[strings.ToUpper(defaultRegion)]
+		if !ok {
+			return Number{}, goval.NewRuleError(CodeRegion, defaultRegion)
+		}
+
+		nsn = digits
+		if region.nationalPrefix != "" && strings.HasPrefix(nsn, region.nationalPrefix) {
+			nsn = nsn[len(region.nationalPrefix):]
+		}
+		regions = md.byCallingCode[region.callingCode]
+	}
+
+	region := regionOf(regions, nsn)
+	if !funcs.Contains(region.lengths, func(n int) bool { return n == len(nsn) }) {
+		return Number{}, goval.NewRuleError(CodeLength, region.region, region.lengths)
+	}
+
+	if !region.matches(nsn) {
+		return Number{}, goval.NewRuleError(CodeNumber, region.region)
+	}
+	return Number{Region: region.region, CallingCode: region.callingCode, NationalNumber: nsn}, nil
+}
+
+// Normalize returns the phone number in E.164, see Parse.
+func Normalize(number, defaultRegion string) (string, error) {
+	n, err := Parse(number, defaultRegion)
+	if err != nil {
+		return "", err
+	}
+	return n.E164(), nil
+}
+
+// Validator is the phone number rule, see PhoneNumber. Each method returns a copy of the rule
+// with the requirement added, so a base rule can be shared safely.
+type Validator struct {
+	countries     []string
+	defaultRegion string
+	mobileOnly    bool
+}
+
+// PhoneNumber returns a rule that ensures that the string is a valid phone number of an allowed region,
+// see Parse for the accepted forms. The allowed regions are ISO 3166-1 alpha-2 codes, compared case-insensitively,
+// no regions allow any region.
+func PhoneNumber(allowedCountries ...string) Validator {
+	return Validator{countries: allowedCountries}
+}
+
+// DefaultRegion accepts the numbers in the national form of the region, e.g. "0812-3456-7890" for "ID".
+func (v Validator) DefaultRegion(region string) Validator {
+	v.defaultRegion = region
+	return v
+}
+
+// MobileOnly rejects the numbers that are not mobile numbers. The regions that do not distinguish
+// the mobile numbers from the fixed-line numbers, such as the NANP regions, accept any valid number.
+func (v Validator) MobileOnly() Validator {
+	v.mobileOnly = true
+	return v
+}
+
+// Validate validates the phone number. Besides the errors of Parse, it returns CodeCountry with the region
+// and the allowed regions if the region is not allowed, and CodeMobile with the region if MobileOnly is set
+// and the number is not a mobile number.
+func (v Validator) Validate(ctx context.Context, value string) error {
+	n, err := Parse(value, v.defaultRegion)
+	if err != nil {
+		return err
+	}
+
+	if len(v.countries) > 0 && !funcs.Contains(v.countries, func(c string) bool { return strings.EqualFold(c, n.Region) }) {
+		return goval.NewRuleError(CodeCountry, n.Region, v.countries)
+	}
+
+	if v.mobileOnly {
+		if mobile, known := getMetadata().byRegion[n.Region].isMobile(n.NationalNumber); known && !mobile {
+			return goval.NewRuleError(CodeMobile, n.Region)
+		}
+	}
+	return nil
+}
+
+// extractDigits returns the digits of the phone number and reports whether it is in the international form.
+// It reports false if the number contains any other character than the digits and the grouping characters.
+func extractDigits(number string) (string, bool, bool) {
+	number = strings.TrimSpace(number)
+	international := strings.HasPrefix(number, "+")
+	number = strings.TrimPrefix(number, "+")
+
+	var sb strings.Builder
+	for _, r := range number {
+		switch {
+		case r >= '0' && r <= '9':
+			sb.WriteRune(r)
+		case strings.ContainsRune(" .-/()", r):
+		default:
+			return "", false, false
+		}
+	}
+
+	digits := sb.String()
+	if !international && strings.HasPrefix(digits, "00") {
+		digits, international = digits[2:], true
+	}
+	return digits, international, digits != ""
+}
+
+// splitCallingCode splits the digits into the calling code, which has 1 to 3 digits, and the national significant number.
+func splitCallingCode(md *metadata, digits string) (int, string, bool) {
+	for i := 1; i <= 3 && i < len(digits); i++ {
+		code, _ := strconv.Atoi(digits[:i])
+		if _, ok := md.byCallingCode[code]; ok {
+			return code, digits[i:], true
+		}
+	}
+	return 0, "", false
+}
+
+// regionOf returns the first region that owns the number, or the main region of the calling code.
+func regionOf(regions []*regionMetadata, nsn string) *regionMetadata {
+	for _, region := range regions {
+		if region.owns(nsn) {
+			return region
+		}
+	}
+	return regions[len(regions)-1]
+}
+
+// minInt returns the smaller of a and b.
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
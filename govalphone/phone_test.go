@@ -0,0 +1,79 @@
+package govalphone_test
+
+import (
+	"testing"
+
+	"github.com/pkg-id/goval/govalphone"
+	"github.com/pkg-id/goval/internal/ruletest"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input         string
+		defaultRegion string
+		region        string
+		e164          string
+	}{
+		{input: "+62 812-3456-7890", region: "ID", e164: "+6281234567890"},
+		{input: "0812 3456 7890", defaultRegion: "id", region: "ID", e164: "+6281234567890"},
+		{input: "0062 812 3456 7890", region: "ID", e164: "+6281234567890"},
+		{input: "+1 (202) 555-0143", region: "US", e164: "+12025550143"},
+		{input: "+1 416 555 0100", region: "CA", e164: "+14165550100"},
+		{input: "(416) 555-0100", defaultRegion: "US", region: "CA", e164: "+14165550100"},
+		{input: "020 7946 0018", defaultRegion: "GB", region: "GB", e164: "+442079460018"},
+		{input: "+39 06 4567 8901", region: "IT", e164: "+390645678901"},
+		{input: "+39 06 6982 1234", region: "VA", e164: "+390669821234"},
+	}
+
+	for _, tc := range tests {
+		n, err := govalphone.Parse(tc.input, tc.defaultRegion)
+		if err != nil {
+			t.Errorf("%s: expect no error; got %v", tc.input, err)
+			continue
+		}
+
+		if n.Region != tc.region || n.E164() != tc.e164 {
+			t.Errorf("%s: expect %s of %s; got %s of %s", tc.input, tc.e164, tc.region, n.E164(), n.Region)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	got, err := govalphone.Normalize("0812-3456-7890", "ID")
+	if err != nil || got != "+6281234567890" {
+		t.Errorf("expect +6281234567890; got %q, %v", got, err)
+	}
+}
+
+func TestPhoneNumber(t *testing.T) {
+	intl := govalphone.PhoneNumber()
+	local := govalphone.PhoneNumber("id", "SG").DefaultRegion("ID")
+	mobile := govalphone.PhoneNumber().MobileOnly()
+
+	ruletest.Run(t, intl, []ruletest.Case{
+		{Desc: "ok", Input: "+62 812-3456-7890"},
+		{Desc: "letters", Input: "+62 812-CALL-NOW", Code: govalphone.CodePhone},
+		{Desc: "empty", Input: "", Code: govalphone.CodePhone},
+		{Desc: "national without default region", Input: "0812-3456-7890", Code: govalphone.CodePhone},
+		{Desc: "unknown calling code", Input: "+999 1234567", Code: govalphone.CodeCallingCode},
+		{Desc: "length", Input: "+62 812 345", Code: govalphone.CodeLength, Args: []any{"ID", []int{7, 8, 9, 10, 11, 12, 13}}},
+		{Desc: "number plan", Input: "+44 4123 456789", Code: govalphone.CodeNumber, Args: []any{"GB"}},
+	})
+
+	ruletest.Run(t, local, []ruletest.Case{
+		{Desc: "national", Input: "0812-3456-7890"},
+		{Desc: "allowed country", Input: "+65 6123 4567"},
+		{Desc: "country", Input: "+44 20 7946 0018", Code: govalphone.CodeCountry, Args: []any{"GB", []string{"id", "SG"}}},
+	})
+
+	ruletest.Run(t, govalphone.PhoneNumber().DefaultRegion("XX"), []ruletest.Case{
+		{Desc: "unknown default region", Input: "0812-3456-7890", Code: govalphone.CodeRegion, Args: []any{"XX"}},
+	})
+
+	ruletest.Run(t, mobile, []ruletest.Case{
+		{Desc: "mobile", Input: "+44 7911 123456"},
+		{Desc: "landline", Input: "+44 20 7946 0018", Code: govalphone.CodeMobile, Args: []any{"GB"}},
+		{Desc: "singapore landline", Input: "+65 6123 4567", Code: govalphone.CodeMobile, Args: []any{"SG"}},
+		{Desc: "nanp is not distinguished", Input: "+1 202 555 0143"},
+	})
+}
@@ -0,0 +1,343 @@
+package govalsemver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg-id/goval"
+)
+
+// ErrConstraintInvalid is returned by ParseConstraint if the constraint is malformed.
+const ErrConstraintInvalid = goval.TextError("semver constraint is invalid")
+
+// Constraint is a version range, see ParseConstraint. The zero Constraint is satisfied by any version.
+type Constraint struct {
+	raw  string
+	sets []comparatorSet
+}
+
+// comparatorSet is the terms that must all be satisfied.
+type comparatorSet struct {
+	raw   string
+	terms []term
+}
+
+// term is a comparison as written in the constraint, e.g. "^1.2", desugared into the primitive comparators.
+type term struct {
+	raw         string
+	comparators []comparator
+}
+
+// comparator is a primitive comparison against a version.
+type comparator struct {
+	op      string // one of "=", "!=", ">", ">=", "<" or "<=".
+	version Version
+}
+
+// ParseConstraint parses the version range. A range is one or more comparator sets separated by "||",
+// it is satisfied if any of its sets is satisfied. A set is one or more terms separated by spaces or commas,
+// it is satisfied if all of its terms are satisfied. The terms are:
+//
+//   - "1.2.3" or "=1.2.3" is the version, "!=1.2.3" is any other version.
+//   - ">1.2.3", ">=1.2.3", "<1.2.3" and "<=1.2.3" compare the versions.
+//   - "1.2", "1.2.x" and "1.2.*" is ">=1.2.0 <1.3.0", "1" and "1.x" is ">=1.0.0 <2.0.0", and "*" is any version.
+//   - "~1.2.3" allows the patch updates, ">=1.2.3 <1.3.0", and "~1" is ">=1.0.0 <2.0.0".
+//   - "^1.2.3" allows the updates that keep the leftmost non-zero number, ">=1.2.3 <2.0.0".
+//     "^0.2.3" is ">=0.2.3 <0.3.0", and "^0.0.3" is ">=0.0.3 <0.0.4".
+//   - "1.2.3 - 2.3" is the inclusive range ">=1.2.3 <2.4.0".
+//
+// The versions in the terms may have a leading "v", and an operator may be separated from its version by spaces.
+// It returns an error wrapping ErrConstraintInvalid if the constraint is malformed.
+func ParseConstraint(s string) (Constraint, error) {
+	c := Constraint{raw: strings.TrimSpace(s)}
+	for _, raw := range strings.Split(s, "||") {
+		set, err := parseComparatorSet(raw)
+		if err != nil {
+			return Constraint{}, err
+		}
+		c.sets = append(c.sets, set)
+	}
+	return c, nil
+}
+
+// MustParseConstraint is like ParseConstraint but panics if the constraint is malformed.
+// It simplifies the initialization of the global constraints.
+func MustParseConstraint(s string) Constraint {
+	c, err := ParseConstraint(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// String returns the constraint as it is parsed.
+func (c Constraint) String() string {
+	return c.raw
+}
+
+// Check reports whether the version satisfies the constraint.
+//
+// A pre-release version only satisfies a comparator set that has a version with a pre-release on the same
+// major, minor and patch numbers, e.g. ">=1.2.3-beta" is satisfied by "1.2.3-rc.1" but not by "1.2.4-rc.1",
+// so the pre-releases must be opted in explicitly.
+func (c Constraint) Check(v Version) bool {
+	_, ok := c.check(v)
+	return ok
+}
+
+// check reports whether the version satisfies the constraint. If not, it returns the failed constraint:
+// the first failed term if the constraint has a single set, otherwise the whole constraint.
+func (c Constraint) check(v Version) (string, bool) {
+	failed := ""
+	for _, set := range c.sets {
+		var ok bool
+		if failed, ok = set.check(v); ok {
+			return "", true
+		}
+	}
+
+	if len(c.sets) > 1 {
+		failed = c.raw
+	}
+	return failed, failed == ""
+}
+
+// check reports whether the version satisfies all of the terms. If not, it returns the first failed term,
+// or the whole set if the version is an excluded pre-release.
+func (s comparatorSet) check(v Version) (string, bool) {
+	for _, t := range s.terms {
+		for _, cmp := range t.comparators {
+			if !cmp.matches(v) {
+				return t.raw, false
+			}
+		}
+	}
+
+	if v.IsPrerelease() && !s.allowsPrerelease(v) {
+		return s.raw, false
+	}
+	return "", true
+}
+
+// allowsPrerelease reports whether any comparator has a pre-release on the same numbers as the version.
+func (s comparatorSet) allowsPrerelease(v Version) bool {
+	for _, t := range s.terms {
+		for _, cmp := range t.comparators {
+			w := cmp.version
+			if w.IsPrerelease() && w.Major == v.Major && w.Minor == v.Minor && w.Patch == v.Patch {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matches reports whether the version satisfies the comparator.
+func (cmp comparator) matches(v Version) bool {
+	c := v.Compare(cmp.version)
+	switch cmp.op {
+	case "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	case "<":
+		return c < 0
+	default:
+		return c <= 0
+	}
+}
+
+// operators are the prefixes of the terms, the longer ones first.
+var operators = []string{">=", "<=", "!=", ">", "<", "=", "^", "~"}
+
+// parseComparatorSet parses the terms of a set, joining the operators with their versions and the hyphen ranges.
+func parseComparatorSet(raw string) (comparatorSet, error) {
+	set := comparatorSet{raw: strings.TrimSpace(raw)}
+	fields := strings.FieldsFunc(raw, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' })
+	if len(fields) == 0 {
+		return comparatorSet{}, fmt.Errorf("%w: empty comparator set", ErrConstraintInvalid)
+	}
+
+	for i := 0; i < len(fields); i++ {
+		field := fields[i]
+		if isOperator(field) && i+1 < len(fields) {
+			i++
+			field += fields[i]
+		}
+
+		var t term
+		var err error
+		if i+2 < len(fields) && fields[i+1] == "-" {
+			t, err = parseHyphenRange(field, fields[i+2])
+			i += 2
+		} else {
+			t, err = parseTerm(field)
+		}
+
+		if err != nil {
+			return comparatorSet{}, err
+		}
+		set.terms = append(set.terms, t)
+	}
+	return set, nil
+}
+
+// isOperator reports whether the field is only an operator.
+func isOperator(field string) bool {
+	for _, op := range operators {
+		if field == op {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTerm parses a term with an optional operator, see ParseConstraint.
+func parseTerm(raw string) (term, error) {
+	op := ""
+	for _, prefix := range operators {
+		if strings.HasPrefix(raw, prefix) {
+			op = prefix
+			break
+		}
+	}
+
+	p, ok := parsePartial(raw[len(op):])
+	if !ok {
+		return term{}, fmt.Errorf("%w: term %q", ErrConstraintInvalid, raw)
+	}
+
+	t := term{raw: raw}
+	switch {
+	case op == "^":
+		t.comparators = caretRange(p)
+	case op == "~":
+		t.comparators = tildeRange(p)
+	case p.parts == 0 && (op == "" || op == "=" || op == ">=" || op == "<="):
+		// any version.
+	case p.parts == 0:
+		return term{}, fmt.Errorf("%w: term %q matches no version", ErrConstraintInvalid, raw)
+	case p.parts == 3:
+		if op == "" {
+			op = "="
+		}
+		t.comparators = []comparator{{op: op, version: p.version}}
+	case op == "" || op == "=":
+		t.comparators = []comparator{{op: ">=", version: p.version}, {op: "<", version: p.upper()}}
+	case op == ">":
+		t.comparators = []comparator{{op: ">=", version: p.upper()}}
+	case op == ">=":
+		t.comparators = []comparator{{op: ">=", version: p.version}}
+	case op == "<":
+		t.comparators = []comparator{{op: "<", version: p.version}}
+	case op == "<=":
+		t.comparators = []comparator{{op: "<", version: p.upper()}}
+	default:
+		return term{}, fmt.Errorf("%w: term %q needs a full version", ErrConstraintInvalid, raw)
+	}
+	return t, nil
+}
+
+// parseHyphenRange parses the inclusive range "from - to", see ParseConstraint.
+func parseHyphenRange(from, to string) (term, error) {
+	raw := from + " - " + to
+	lower, ok := parsePartial(from)
+	upper, ok2 := parsePartial(to)
+	if !ok || !ok2 {
+		return term{}, fmt.Errorf("%w: range %q", ErrConstraintInvalid, raw)
+	}
+
+	t := term{raw: raw}
+	if lower.parts > 0 {
+		t.comparators = append(t.comparators, comparator{op: ">=", version: lower.version})
+	}
+
+	switch {
+	case upper.parts == 3:
+		t.comparators = append(t.comparators, comparator{op: "<=", version: upper.version})
+	case upper.parts > 0:
+		t.comparators = append(t.comparators, comparator{op: "<", version: upper.upper()})
+	}
+	return t, nil
+}
+
+// caretRange returns the comparators of "^" on the partial version, see ParseConstraint.
+func caretRange(p partial) []comparator {
+	v := p.version
+	var upper Version
+	switch {
+	case p.parts == 0:
+		return nil
+	case v.Major > 0 || p.parts == 1:
+		upper = Version{Major: v.Major + 1}
+	case v.Minor > 0 || p.parts == 2:
+		upper = Version{Minor: v.Minor + 1}
+	default:
+		upper = Version{Patch: v.Patch + 1}
+	}
+	return []comparator{{op: ">=", version: v}, {op: "<", version: upper}}
+}
+
+// tildeRange returns the comparators of "~" on the partial version, see ParseConstraint.
+func tildeRange(p partial) []comparator {
+	v := p.version
+	var upper Version
+	switch p.parts {
+	case 0:
+		return nil
+	case 1:
+		upper = Version{Major: v.Major + 1}
+	default:
+		upper = Version{Major: v.Major, Minor: v.Minor + 1}
+	}
+	return []comparator{{op: ">=", version: v}, {op: "<", version: upper}}
+}
+
+// partial is a version in a term, where the trailing numbers may be omitted or wildcards.
+type partial struct {
+	version Version // the given numbers, the others are zero.
+	parts   int     // the number of the given numbers, 0 to 3.
+}
+
+// parsePartial parses a partial version, e.g. "1", "1.2.x" or "v1.2.3-rc.1".
+// The pre-release and the build metadata are only allowed on the full version.
+func parsePartial(s string) (partial, bool) {
+	s = strings.TrimPrefix(s, "v")
+	if v, ok := parseVersion(s); ok {
+		return partial{version: v, parts: 3}, true
+	}
+
+	fields := strings.Split(s, ".")
+	if len(fields) > 3 {
+		return partial{}, false
+	}
+
+	var p partial
+	numbers := []*uint64{&p.version.Major, &p.version.Minor, &p.version.Patch}
+	for i, field := range fields {
+		if field == "x" || field == "X" || field == "*" {
+			continue
+		}
+
+		n, ok := parseNumber(field)
+		if !ok || p.parts != i {
+			return partial{}, false
+		}
+		*numbers[i] = n
+		p.parts++
+	}
+	return p, true
+}
+
+// upper returns the lowest version above the partial version, e.g. "1.3.0" for "1.2".
+func (p partial) upper() Version {
+	v := p.version
+	if p.parts == 1 {
+		return Version{Major: v.Major + 1}
+	}
+	return Version{Major: v.Major, Minor: v.Minor + 1}
+}
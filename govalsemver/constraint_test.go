@@ -0,0 +1,88 @@
+package govalsemver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pkg-id/goval/govalsemver"
+	"github.com/pkg-id/goval/internal/ruletest"
+)
+
+func TestConstraint_Check(t *testing.T) {
+	tests := []struct {
+		constraint string
+		accepts    []string
+		rejects    []string
+	}{
+		{constraint: "1.2.3", accepts: []string{"1.2.3", "1.2.3+b"}, rejects: []string{"1.2.4", "1.2.3-rc.1"}},
+		{constraint: "=v1.2.3", accepts: []string{"1.2.3"}, rejects: []string{"1.2.2"}},
+		{constraint: "!=1.2.3", accepts: []string{"1.2.4"}, rejects: []string{"1.2.3"}},
+		{constraint: ">1.2.3", accepts: []string{"1.2.4"}, rejects: []string{"1.2.3"}},
+		{constraint: ">= 1.2.3", accepts: []string{"1.2.3", "9.0.0"}, rejects: []string{"1.2.2"}},
+		{constraint: "<1.2.3", accepts: []string{"1.2.2"}, rejects: []string{"1.2.3"}},
+		{constraint: "<=1.2.3", accepts: []string{"1.2.3"}, rejects: []string{"1.2.4"}},
+		{constraint: "1.2", accepts: []string{"1.2.0", "1.2.99"}, rejects: []string{"1.1.9", "1.3.0"}},
+		{constraint: "1.x", accepts: []string{"1.0.0", "1.99.0"}, rejects: []string{"2.0.0", "0.9.0"}},
+		{constraint: "1.2.*", accepts: []string{"1.2.5"}, rejects: []string{"1.3.0"}},
+		{constraint: "*", accepts: []string{"0.0.0", "99.0.0"}, rejects: []string{"1.0.0-rc.1"}},
+		{constraint: ">1.2", accepts: []string{"1.3.0"}, rejects: []string{"1.2.9"}},
+		{constraint: "<=1.2", accepts: []string{"1.2.9"}, rejects: []string{"1.3.0"}},
+		{constraint: "<1.2", accepts: []string{"1.1.9"}, rejects: []string{"1.2.0"}},
+		{constraint: "~1.2.3", accepts: []string{"1.2.3", "1.2.9"}, rejects: []string{"1.2.2", "1.3.0"}},
+		{constraint: "~1.2", accepts: []string{"1.2.0"}, rejects: []string{"1.3.0"}},
+		{constraint: "~1", accepts: []string{"1.9.0"}, rejects: []string{"2.0.0"}},
+		{constraint: "^1.2.3", accepts: []string{"1.2.3", "1.9.0"}, rejects: []string{"1.2.2", "2.0.0"}},
+		{constraint: "^1.2", accepts: []string{"1.2.0", "1.9.0"}, rejects: []string{"1.1.0", "2.0.0"}},
+		{constraint: "^0.2.3", accepts: []string{"0.2.9"}, rejects: []string{"0.3.0"}},
+		{constraint: "^0.0.3", accepts: []string{"0.0.3"}, rejects: []string{"0.0.4"}},
+		{constraint: "^0.0", accepts: []string{"0.0.9"}, rejects: []string{"0.1.0"}},
+		{constraint: "^0", accepts: []string{"0.9.0"}, rejects: []string{"1.0.0"}},
+		{constraint: "1.2.3 - 2.3", accepts: []string{"1.2.3", "2.3.9"}, rejects: []string{"1.2.2", "2.4.0"}},
+		{constraint: "1.2 - 2.3.4", accepts: []string{"1.2.0", "2.3.4"}, rejects: []string{"2.3.5"}},
+		{constraint: ">=1.0.0, <1.1.0", accepts: []string{"1.0.5"}, rejects: []string{"1.1.0"}},
+		{constraint: "^1.0 || ^3.0", accepts: []string{"1.5.0", "3.1.0"}, rejects: []string{"2.0.0"}},
+		{constraint: ">=1.2.3-beta", accepts: []string{"1.2.3-beta", "1.2.3-rc.1", "1.3.0"}, rejects: []string{"1.2.3-alpha", "1.2.4-rc.1"}},
+	}
+
+	for _, tc := range tests {
+		c, err := govalsemver.ParseConstraint(tc.constraint)
+		if err != nil {
+			t.Errorf("ParseConstraint(%q): expect no error; got %v", tc.constraint, err)
+			continue
+		}
+
+		for _, s := range tc.accepts {
+			if v, _ := govalsemver.Parse(s); !c.Check(v) {
+				t.Errorf("%q: expect %s to satisfy", tc.constraint, s)
+			}
+		}
+
+		for _, s := range tc.rejects {
+			if v, _ := govalsemver.Parse(s); c.Check(v) {
+				t.Errorf("%q: expect %s not to satisfy", tc.constraint, s)
+			}
+		}
+	}
+}
+
+func TestParseConstraint_Invalid(t *testing.T) {
+	for _, s := range []string{"", "||", "^1.0 ||", ">=", "1.2.3.4", "1.x.3", "01.2", "!=1.2", ">*", ">=1.0 - 2.0", "1.2.3 -", "abc"} {
+		if _, err := govalsemver.ParseConstraint(s); !errors.Is(err, govalsemver.ErrConstraintInvalid) {
+			t.Errorf("ParseConstraint(%q): expect ErrConstraintInvalid; got %v", s, err)
+		}
+	}
+}
+
+func TestSemVer_ConstraintArgs(t *testing.T) {
+	validator := govalsemver.SemVer(govalsemver.Options{Constraint: govalsemver.MustParseConstraint("^1.2 || ~3.1")})
+	ruletest.Run(t, validator, []ruletest.Case{
+		{Desc: "first set", Input: "1.4.0"},
+		{Desc: "second set", Input: "3.1.2"},
+		{Desc: "none", Input: "3.2.0", Code: govalsemver.CodeConstraint, Args: []any{"3.2.0", "^1.2 || ~3.1"}},
+	})
+
+	validator = govalsemver.SemVer(govalsemver.Options{Constraint: govalsemver.MustParseConstraint("^ 1.2")})
+	ruletest.Run(t, validator, []ruletest.Case{
+		{Desc: "caret term", Input: "2.0.0", Code: govalsemver.CodeConstraint, Args: []any{"2.0.0", "^1.2"}},
+	})
+}
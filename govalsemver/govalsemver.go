@@ -0,0 +1,25 @@
+// Package govalsemver provides the rules for the semantic versions, see https://semver.org,
+// and the range constraints in the common caret, tilde, wildcard, hyphen and comparator syntax.
+//
+//	plugin := govalsemver.MustParseConstraint(">=1.4.0 <2.0.0")
+//	goval.Named("version", m.Version, govalsemver.SemVer(govalsemver.Options{ForbidPrerelease: true, Constraint: plugin}))
+package govalsemver
+
+import (
+	"github.com/pkg-id/goval"
+	"github.com/pkg-id/goval/internal/rulecode"
+)
+
+const (
+	CodeVersion    = rulecode.Code("govalsemver.version")
+	CodePrerelease = rulecode.Code("govalsemver.prerelease")
+	CodeConstraint = rulecode.Code("govalsemver.constraint")
+)
+
+func init() {
+	goval.MustRegisterRuleCode(
+		rulecode.Info(CodeVersion),
+		rulecode.Info(CodePrerelease, "version"),
+		rulecode.Info(CodeConstraint, "version", "constraint"),
+	)
+}
@@ -0,0 +1,228 @@
+package govalsemver
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/pkg-id/goval"
+)
+
+// Version is a semantic version.
+type Version struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	Prerelease []string // the pre-release identifiers, e.g. ["rc", "1"] for "1.0.0-rc.1".
+	Build      []string // the build metadata identifiers, they are ignored by Compare.
+}
+
+// Parse parses the string as a semantic version 2.0.0, e.g. "1.2.3", "1.0.0-rc.1" or "1.0.0+20240101".
+// The numbers must not have leading zeros and must fit in an uint64, and a leading "v" is not accepted.
+//
+// It returns a goval.RuleError with CodeVersion if the string is not a semantic version.
+func Parse(s string) (Version, error) {
+	v, ok := parseVersion(s)
+	if !ok {
+		return Version{}, goval.NewRuleError(CodeVersion)
+	}
+	return v, nil
+}
+
+// String returns the version in the canonical form, e.g. "1.0.0-rc.1+20240101".
+func (v Version) String() string {
+	var sb strings.Builder
+	sb.WriteString(strconv.FormatUint(v.Major, 10))
+	sb.WriteByte('.')
+	sb.WriteString(strconv.FormatUint(v.Minor, 10))
+	sb.WriteByte('.')
+	sb.WriteString(strconv.FormatUint(v.Patch, 10))
+	if len(v.Prerelease) > 0 {
+		sb.WriteByte('-')
+		sb.WriteString(strings.Join(v.Prerelease, "."))
+	}
+	if len(v.Build) > 0 {
+		sb.WriteByte('+')
+		sb.WriteString(strings.Join(v.Build, "."))
+	}
+	return sb.String()
+}
+
+// IsPrerelease reports whether the version has pre-release identifiers.
+func (v Version) IsPrerelease() bool {
+	return len(v.Prerelease) > 0
+}
+
+// Compare returns -1, 0 or +1 if the version has lower, equal or higher precedence than the other,
+// see https://semver.org/#spec-item-11. The build metadata are ignored.
+func (v Version) Compare(other Version) int {
+	if c := compareUint(v.Major, other.Major); c != 0 {
+		return c
+	}
+	if c := compareUint(v.Minor, other.Minor); c != 0 {
+		return c
+	}
+	if c := compareUint(v.Patch, other.Patch); c != 0 {
+		return c
+	}
+	return comparePrerelease(v.Prerelease, other.Prerelease)
+}
+
+// Options is the policy of the SemVer rule.
+type Options struct {
+	// AllowPrefix accepts a leading "v", e.g. "v1.2.3" as used by the git tags and the Go modules.
+	AllowPrefix bool
+	// ForbidPrerelease rejects the pre-release versions, e.g. "1.0.0-rc.1".
+	ForbidPrerelease bool
+	// Constraint is the range that the version must satisfy, the zero Constraint is satisfied by any version.
+	// Note that a constraint rejects most pre-release versions by itself, see Constraint.Check.
+	Constraint Constraint
+}
+
+// SemVer ensures that the string is a semantic version, see Parse, that satisfies the options.
+//
+// Besides the errors of Parse, it returns CodePrerelease with the version if ForbidPrerelease is set and the version
+// is a pre-release, and CodeConstraint with the version and the failed constraint if the constraint is not satisfied.
+// The version in the args is in the canonical form, see Constraint.Check for the failed constraint.
+func SemVer(opts Options) goval.StringValidator {
+	return func(ctx context.Context, value string) error {
+		if opts.AllowPrefix {
+			value = strings.TrimPrefix(value, "v")
+		}
+
+		v, err := Parse(value)
+		if err != nil {
+			return err
+		}
+
+		if opts.ForbidPrerelease && v.IsPrerelease() {
+			return goval.NewRuleError(CodePrerelease, v.String())
+		}
+
+		if failed, ok := opts.Constraint.check(v); !ok {
+			return goval.NewRuleError(CodeConstraint, v.String(), failed)
+		}
+		return nil
+	}
+}
+
+// parseVersion parses the string as a semantic version, see Parse.
+func parseVersion(s string) (Version, bool) {
+	var v Version
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		v.Build, s = strings.Split(s[i+1:], "."), s[:i]
+		for _, id := range v.Build {
+			if !isIdentifier(id) {
+				return Version{}, false
+			}
+		}
+	}
+
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		v.Prerelease, s = strings.Split(s[i+1:], "."), s[:i]
+		for _, id := range v.Prerelease {
+			if !isIdentifier(id) || isNumeric(id) && len(id) > 1 && id[0] == '0' {
+				return Version{}, false
+			}
+		}
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return Version{}, false
+	}
+
+	for i, n := range []*uint64{&v.Major, &v.Minor, &v.Patch} {
+		var ok bool
+		if *n, ok = parseNumber(parts[i]); !ok {
+			return Version{}, false
+		}
+	}
+	return v, true
+}
+
+// parseNumber parses a version number, which has no leading zeros.
+func parseNumber(s string) (uint64, bool) {
+	if !isNumeric(s) || len(s) > 1 && s[0] == '0' {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	return n, err == nil
+}
+
+// isIdentifier reports whether s is a non-empty identifier of ASCII letters, digits and hyphens.
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// isNumeric reports whether s is a non-empty string of ASCII digits.
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// compareUint compares a and b.
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// comparePrerelease compares the pre-release identifiers, a version without them has the higher precedence.
+func comparePrerelease(a, b []string) int {
+	switch {
+	case len(a) == 0 && len(b) == 0:
+		return 0
+	case len(a) == 0:
+		return 1
+	case len(b) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := compareIdentifier(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return compareUint(uint64(len(a)), uint64(len(b)))
+}
+
+// compareIdentifier compares the pre-release identifiers, the numeric ones are compared numerically
+// and have lower precedence than the alphanumeric ones.
+func compareIdentifier(a, b string) int {
+	aNum, bNum := isNumeric(a), isNumeric(b)
+	switch {
+	case aNum && bNum:
+		if c := compareUint(uint64(len(a)), uint64(len(b))); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	case aNum:
+		return -1
+	case bNum:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
@@ -0,0 +1,95 @@
+package govalsemver_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pkg-id/goval/govalsemver"
+	"github.com/pkg-id/goval/internal/ruletest"
+)
+
+func TestParse(t *testing.T) {
+	v, err := govalsemver.Parse("1.20.3-rc.1+build.5")
+	if err != nil {
+		t.Fatalf("expect no error; got %v", err)
+	}
+
+	want := govalsemver.Version{Major: 1, Minor: 20, Patch: 3, Prerelease: []string{"rc", "1"}, Build: []string{"build", "5"}}
+	if !reflect.DeepEqual(v, want) {
+		t.Errorf("expect %v; got %v", want, v)
+	}
+
+	if got := v.String(); got != "1.20.3-rc.1+build.5" {
+		t.Errorf("expect the canonical form; got %q", got)
+	}
+}
+
+func TestVersion_Compare(t *testing.T) {
+	// the precedence example of https://semver.org/#spec-item-11, in ascending order.
+	ordered := []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.1.0", "2.0.0", "10.0.0",
+	}
+
+	for i := range ordered {
+		for j := range ordered {
+			a, _ := govalsemver.Parse(ordered[i])
+			b, _ := govalsemver.Parse(ordered[j])
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+
+			if got := a.Compare(b); got != want {
+				t.Errorf("Compare(%s, %s): expect %d; got %d", a, b, want, got)
+			}
+		}
+	}
+
+	a, _ := govalsemver.Parse("1.0.0+a")
+	b, _ := govalsemver.Parse("1.0.0+b")
+	if a.Compare(b) != 0 {
+		t.Errorf("expect the build metadata to be ignored")
+	}
+}
+
+func TestSemVer(t *testing.T) {
+	ruletest.Run(t, govalsemver.SemVer(govalsemver.Options{}), []ruletest.Case{
+		{Desc: "release", Input: "1.2.3"},
+		{Desc: "zeros", Input: "0.0.0"},
+		{Desc: "pre-release", Input: "1.0.0-alpha-1.0"},
+		{Desc: "build metadata with leading zero", Input: "1.0.0+001"},
+		{Desc: "max uint64", Input: "18446744073709551615.0.0"},
+		{Desc: "empty", Input: "", Code: govalsemver.CodeVersion},
+		{Desc: "prefix", Input: "v1.2.3", Code: govalsemver.CodeVersion},
+		{Desc: "partial", Input: "1.2", Code: govalsemver.CodeVersion},
+		{Desc: "four numbers", Input: "1.2.3.4", Code: govalsemver.CodeVersion},
+		{Desc: "leading zero", Input: "01.2.3", Code: govalsemver.CodeVersion},
+		{Desc: "numeric pre-release leading zero", Input: "1.2.3-01", Code: govalsemver.CodeVersion},
+		{Desc: "empty pre-release identifier", Input: "1.2.3-rc..1", Code: govalsemver.CodeVersion},
+		{Desc: "empty build", Input: "1.2.3+", Code: govalsemver.CodeVersion},
+		{Desc: "invalid character", Input: "1.2.3-rc_1", Code: govalsemver.CodeVersion},
+		{Desc: "overflow", Input: "18446744073709551616.0.0", Code: govalsemver.CodeVersion},
+		{Desc: "spaces", Input: " 1.2.3", Code: govalsemver.CodeVersion},
+	})
+}
+
+func TestSemVer_Options(t *testing.T) {
+	ruletest.Run(t, govalsemver.SemVer(govalsemver.Options{AllowPrefix: true, ForbidPrerelease: true}), []ruletest.Case{
+		{Desc: "prefix", Input: "v1.2.3"},
+		{Desc: "without prefix", Input: "1.2.3"},
+		{Desc: "pre-release", Input: "v1.0.0-rc.1+b", Code: govalsemver.CodePrerelease, Args: []any{"1.0.0-rc.1+b"}},
+		{Desc: "double prefix", Input: "vv1.2.3", Code: govalsemver.CodeVersion},
+	})
+
+	plugin := govalsemver.MustParseConstraint(">=1.4.0 <2.0.0")
+	ruletest.Run(t, govalsemver.SemVer(govalsemver.Options{Constraint: plugin}), []ruletest.Case{
+		{Desc: "in range", Input: "1.9.9"},
+		{Desc: "lower bound", Input: "1.4.0"},
+		{Desc: "below", Input: "1.3.9", Code: govalsemver.CodeConstraint, Args: []any{"1.3.9", ">=1.4.0"}},
+		{Desc: "above", Input: "2.0.0", Code: govalsemver.CodeConstraint, Args: []any{"2.0.0", "<2.0.0"}},
+		{Desc: "pre-release", Input: "1.5.0-beta", Code: govalsemver.CodeConstraint, Args: []any{"1.5.0-beta", ">=1.4.0 <2.0.0"}},
+	})
+}
@@ -0,0 +1,277 @@
+package goval
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// graphemeBreak is the Grapheme_Cluster_Break property of a rune, as defined by UAX #29.
+type graphemeBreak int
+
+const (
+	gbOther graphemeBreak = iota
+	gbCR
+	gbLF
+	gbControl
+	gbExtend
+	gbZWJ
+	gbRegionalIndicator
+	gbPrepend
+	gbSpacingMark
+	gbL
+	gbV
+	gbT
+	gbLV
+	gbLVT
+)
+
+// rangeTable creates a unicode.RangeTable from the sorted and non-overlapping inclusive ranges.
+func rangeTable(ranges ...[2]rune) *unicode.RangeTable {
+	var rt unicode.RangeTable
+	for _, r := range ranges {
+		if r[1] <= 0xFFFF {
+			rt.R16 = append(rt.R16, unicode.Range16{Lo: uint16(r[0]), Hi: uint16(r[1]), Stride: 1})
+			if r[1] <= unicode.MaxLatin1 {
+				rt.LatinOffset++
+			}
+		} else {
+			rt.R32 = append(rt.R32, unicode.Range32{Lo: uint32(r[0]), Hi: uint32(r[1]), Stride: 1})
+		}
+	}
+	return &rt
+}
+
+// extendedPictographic is the Extended_Pictographic property from the Unicode emoji data,
+// which is not provided by the unicode package.
+var extendedPictographic = rangeTable(
+	[2]rune{0x00A9, 0x00A9}, [2]rune{0x00AE, 0x00AE}, [2]rune{0x203C, 0x203C}, [2]rune{0x2049, 0x2049},
+	[2]rune{0x2122, 0x2122}, [2]rune{0x2139, 0x2139}, [2]rune{0x2194, 0x2199}, [2]rune{0x21A9, 0x21AA},
+	[2]rune{0x231A, 0x231B}, [2]rune{0x2328, 0x2328}, [2]rune{0x2388, 0x2388}, [2]rune{0x23CF, 0x23CF},
+	[2]rune{0x23E9, 0x23F3}, [2]rune{0x23F8, 0x23FA}, [2]rune{0x24C2, 0x24C2}, [2]rune{0x25AA, 0x25AB},
+	[2]rune{0x25B6, 0x25B6}, [2]rune{0x25C0, 0x25C0}, [2]rune{0x25FB, 0x25FE}, [2]rune{0x2600, 0x2605},
+	[2]rune{0x2607, 0x2612}, [2]rune{0x2614, 0x2685}, [2]rune{0x2690, 0x2705}, [2]rune{0x2708, 0x2712},
+	[2]rune{0x2714, 0x2714}, [2]rune{0x2716, 0x2716}, [2]rune{0x271D, 0x271D}, [2]rune{0x2721, 0x2721},
+	[2]rune{0x2728, 0x2728}, [2]rune{0x2733, 0x2734}, [2]rune{0x2744, 0x2744}, [2]rune{0x2747, 0x2747},
+	[2]rune{0x274C, 0x274C}, [2]rune{0x274E, 0x274E}, [2]rune{0x2753, 0x2755}, [2]rune{0x2757, 0x2757},
+	[2]rune{0x2763, 0x2767}, [2]rune{0x2795, 0x2797}, [2]rune{0x27A1, 0x27A1}, [2]rune{0x27B0, 0x27B0},
+	[2]rune{0x27BF, 0x27BF}, [2]rune{0x2934, 0x2935}, [2]rune{0x2B05, 0x2B07}, [2]rune{0x2B1B, 0x2B1C},
+	[2]rune{0x2B50, 0x2B50}, [2]rune{0x2B55, 0x2B55}, [2]rune{0x3030, 0x3030}, [2]rune{0x303D, 0x303D},
+	[2]rune{0x3297, 0x3297}, [2]rune{0x3299, 0x3299}, [2]rune{0x1F000, 0x1F0FF}, [2]rune{0x1F10D, 0x1F10F},
+	[2]rune{0x1F12F, 0x1F12F}, [2]rune{0x1F16C, 0x1F171}, [2]rune{0x1F17E, 0x1F17F}, [2]rune{0x1F18E, 0x1F18E},
+	[2]rune{0x1F191, 0x1F19A}, [2]rune{0x1F1AD, 0x1F1E5}, [2]rune{0x1F201, 0x1F20F}, [2]rune{0x1F21A, 0x1F21A},
+	[2]rune{0x1F22F, 0x1F22F}, [2]rune{0x1F232, 0x1F23A}, [2]rune{0x1F23C, 0x1F23F}, [2]rune{0x1F249, 0x1F3FA},
+	[2]rune{0x1F400, 0x1F53D}, [2]rune{0x1F546, 0x1F64F}, [2]rune{0x1F680, 0x1F6FF}, [2]rune{0x1F774, 0x1F77F},
+	[2]rune{0x1F7D5, 0x1F7FF}, [2]rune{0x1F80C, 0x1F80F}, [2]rune{0x1F848, 0x1F84F}, [2]rune{0x1F85A, 0x1F85F},
+	[2]rune{0x1F888, 0x1F88F}, [2]rune{0x1F8AE, 0x1F8FF}, [2]rune{0x1F90C, 0x1F93A}, [2]rune{0x1F93C, 0x1F945},
+	[2]rune{0x1F947, 0x1FAFF}, [2]rune{0x1FC00, 0x1FFFD},
+)
+
+// emojiModifier is the Emoji_Modifier property, the skin tones are treated as Extend since Unicode 11.
+var emojiModifier = rangeTable([2]rune{0x1F3FB, 0x1F3FF})
+
+// graphemePrepend is the Prepend property, the Prepended_Concatenation_Mark and a few Indic letters.
+var graphemePrepend = rangeTable(
+	[2]rune{0x0D4E, 0x0D4E}, [2]rune{0x111C2, 0x111C3}, [2]rune{0x1193F, 0x1193F}, [2]rune{0x11941, 0x11941},
+	[2]rune{0x11A3A, 0x11A3A}, [2]rune{0x11A84, 0x11A89}, [2]rune{0x11D46, 0x11D46},
+)
+
+// eastAsianWide contains the runes with the East_Asian_Width property of Wide or Fullwidth,
+// which are displayed in two columns by the terminals.
+var eastAsianWide = rangeTable(
+	[2]rune{0x1100, 0x115F}, [2]rune{0x231A, 0x231B}, [2]rune{0x2329, 0x232A}, [2]rune{0x23E9, 0x23EC},
+	[2]rune{0x23F0, 0x23F0}, [2]rune{0x23F3, 0x23F3}, [2]rune{0x25FD, 0x25FE}, [2]rune{0x2614, 0x2615},
+	[2]rune{0x2648, 0x2653}, [2]rune{0x267F, 0x267F}, [2]rune{0x2693, 0x2693}, [2]rune{0x26A1, 0x26A1},
+	[2]rune{0x26AA, 0x26AB}, [2]rune{0x26BD, 0x26BE}, [2]rune{0x26C4, 0x26C5}, [2]rune{0x26CE, 0x26CE},
+	[2]rune{0x26D4, 0x26D4}, [2]rune{0x26EA, 0x26EA}, [2]rune{0x26F2, 0x26F3}, [2]rune{0x26F5, 0x26F5},
+	[2]rune{0x26FA, 0x26FA}, [2]rune{0x26FD, 0x26FD}, [2]rune{0x2705, 0x2705}, [2]rune{0x270A, 0x270B},
+	[2]rune{0x2728, 0x2728}, [2]rune{0x274C, 0x274C}, [2]rune{0x274E, 0x274E}, [2]rune{0x2753, 0x2755},
+	[2]rune{0x2757, 0x2757}, [2]rune{0x2795, 0x2797}, [2]rune{0x27B0, 0x27B0}, [2]rune{0x27BF, 0x27BF},
+	[2]rune{0x2B1B, 0x2B1C}, [2]rune{0x2B50, 0x2B50}, [2]rune{0x2B55, 0x2B55}, [2]rune{0x2E80, 0x303E},
+	[2]rune{0x3041, 0x33FF}, [2]rune{0x3400, 0x4DBF}, [2]rune{0x4E00, 0x9FFF}, [2]rune{0xA000, 0xA4CF},
+	[2]rune{0xA960, 0xA97F}, [2]rune{0xAC00, 0xD7A3}, [2]rune{0xF900, 0xFAFF}, [2]rune{0xFE10, 0xFE19},
+	[2]rune{0xFE30, 0xFE6F}, [2]rune{0xFF00, 0xFF60}, [2]rune{0xFFE0, 0xFFE6}, [2]rune{0x16FE0, 0x16FE4},
+	[2]rune{0x17000, 0x18AFF}, [2]rune{0x1B000, 0x1B2FF}, [2]rune{0x1F004, 0x1F004}, [2]rune{0x1F0CF, 0x1F0CF},
+	[2]rune{0x1F18E, 0x1F18E}, [2]rune{0x1F191, 0x1F19A}, [2]rune{0x1F200, 0x1F202}, [2]rune{0x1F210, 0x1F23B},
+	[2]rune{0x1F240, 0x1F248}, [2]rune{0x1F250, 0x1F251}, [2]rune{0x1F260, 0x1F265}, [2]rune{0x1F300, 0x1F320},
+	[2]rune{0x1F32D, 0x1F335}, [2]rune{0x1F337, 0x1F37C}, [2]rune{0x1F37E, 0x1F393}, [2]rune{0x1F3A0, 0x1F3CA},
+	[2]rune{0x1F3CF, 0x1F3D3}, [2]rune{0x1F3E0, 0x1F3F0}, [2]rune{0x1F3F4, 0x1F3F4}, [2]rune{0x1F3F8, 0x1F43E},
+	[2]rune{0x1F440, 0x1F440}, [2]rune{0x1F442, 0x1F4FC}, [2]rune{0x1F4FF, 0x1F53D}, [2]rune{0x1F54B, 0x1F54E},
+	[2]rune{0x1F550, 0x1F567}, [2]rune{0x1F57A, 0x1F57A}, [2]rune{0x1F595, 0x1F596}, [2]rune{0x1F5A4, 0x1F5A4},
+	[2]rune{0x1F5FB, 0x1F64F}, [2]rune{0x1F680, 0x1F6C5}, [2]rune{0x1F6CC, 0x1F6CC}, [2]rune{0x1F6D0, 0x1F6D2},
+	[2]rune{0x1F6D5, 0x1F6D7}, [2]rune{0x1F6EB, 0x1F6EC}, [2]rune{0x1F6F4, 0x1F6FC}, [2]rune{0x1F7E0, 0x1F7EB},
+	[2]rune{0x1F90C, 0x1F93A}, [2]rune{0x1F93C, 0x1F945}, [2]rune{0x1F947, 0x1F9FF}, [2]rune{0x1FA70, 0x1FAFF},
+	[2]rune{0x20000, 0x2FFFD}, [2]rune{0x30000, 0x3FFFD},
+)
+
+// Hangul syllables, see the Hangul Syllable Type property.
+const (
+	hangulSBase  = 0xAC00
+	hangulSCount = 11172
+	hangulTCount = 28
+)
+
+// graphemeBreakOf returns the Grapheme_Cluster_Break property of the rune.
+func graphemeBreakOf(r rune) graphemeBreak {
+	switch {
+	case r == '\r':
+		return gbCR
+	case r == '\n':
+		return gbLF
+	case r == 0x200D:
+		return gbZWJ
+	case r == 0x200C:
+		return gbExtend
+	case r < 0x20 || r == 0x7F:
+		return gbControl
+	case r < 0x300:
+		// fast path for Latin, there is no other property below the combining diacritical marks.
+		if r >= 0x80 && r <= 0x9F || r == 0xAD {
+			return gbControl
+		}
+		return gbOther
+	case r >= 0x1100 && r <= 0x115F || r >= 0xA960 && r <= 0xA97C:
+		return gbL
+	case r >= 0x1160 && r <= 0x11A7 || r >= 0xD7B0 && r <= 0xD7C6:
+		return gbV
+	case r >= 0x11A8 && r <= 0x11FF || r >= 0xD7CB && r <= 0xD7FB:
+		return gbT
+	case r >= hangulSBase && r < hangulSBase+hangulSCount:
+		if (r-hangulSBase)%hangulTCount == 0 {
+			return gbLV
+		}
+		return gbLVT
+	case unicode.Is(unicode.Regional_Indicator, r):
+		return gbRegionalIndicator
+	case unicode.Is(unicode.Prepended_Concatenation_Mark, r) || unicode.Is(graphemePrepend, r):
+		return gbPrepend
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Other_Grapheme_Extend, emojiModifier):
+		return gbExtend
+	case unicode.In(r, unicode.Zl, unicode.Zp, unicode.Cc, unicode.Cf):
+		return gbControl
+	case unicode.Is(unicode.Mc, r) || r == 0x0E33 || r == 0x0EB3:
+		return gbSpacingMark
+	}
+	return gbOther
+}
+
+// graphemeClusters calls fn for each extended grapheme cluster of s, following the boundary rules of UAX #29.
+// The Indic conjunct rule (GB9c) is not applied, a conjunct is counted as multiple clusters.
+func graphemeClusters(s string, fn func(cluster string)) {
+	start := 0
+	prev := gbControl
+	inPictographic := false // the cluster so far is ExtPict Extend*.
+	afterPictographicZWJ := false
+	regionalIndicators := 0
+
+	for i, r := range s {
+		gb := graphemeBreakOf(r)
+		pictographic := gb == gbOther && unicode.Is(extendedPictographic, r)
+
+		if i > 0 && isGraphemeBoundary(prev, gb, pictographic, afterPictographicZWJ, regionalIndicators) {
+			fn(s[start:i])
+			start = i
+		}
+
+		switch {
+		case pictographic:
+			inPictographic = true
+			afterPictographicZWJ = false
+		case gb == gbExtend && inPictographic:
+			afterPictographicZWJ = false
+		case gb == gbZWJ && inPictographic:
+			afterPictographicZWJ = true
+			inPictographic = false
+		default:
+			inPictographic = false
+			afterPictographicZWJ = false
+		}
+
+		if gb == gbRegionalIndicator {
+			regionalIndicators++
+		} else {
+			regionalIndicators = 0
+		}
+
+		prev = gb
+	}
+
+	if start < len(s) {
+		fn(s[start:])
+	}
+}
+
+// isGraphemeBoundary reports whether there is a boundary between a rune with the property prev and
+// the next rune with the property next.
+func isGraphemeBoundary(prev, next graphemeBreak, pictographic, afterPictographicZWJ bool, regionalIndicators int) bool {
+	switch {
+	case prev == gbCR && next == gbLF: // GB3
+		return false
+	case prev == gbCR || prev == gbLF || prev == gbControl: // GB4
+		return true
+	case next == gbCR || next == gbLF || next == gbControl: // GB5
+		return true
+	case prev == gbL && (next == gbL || next == gbV || next == gbLV || next == gbLVT): // GB6
+		return false
+	case (prev == gbLV || prev == gbV) && (next == gbV || next == gbT): // GB7
+		return false
+	case (prev == gbLVT || prev == gbT) && next == gbT: // GB8
+		return false
+	case next == gbExtend || next == gbZWJ: // GB9
+		return false
+	case next == gbSpacingMark: // GB9a
+		return false
+	case prev == gbPrepend: // GB9b
+		return false
+	case prev == gbZWJ && pictographic && afterPictographicZWJ: // GB11
+		return false
+	case prev == gbRegionalIndicator && next == gbRegionalIndicator: // GB12 and GB13
+		return regionalIndicators%2 == 0
+	}
+	return true // GB999
+}
+
+// graphemeCount returns the number of user-perceived characters of s.
+func graphemeCount(s string) int {
+	n := 0
+	graphemeClusters(s, func(string) { n++ })
+	return n
+}
+
+// displayWidth returns the number of terminal columns that s occupies.
+// Each grapheme cluster is either zero, one or two columns wide, the wide and fullwidth characters,
+// the emoji presentation sequences and the flags are two columns wide.
+func displayWidth(s string) int {
+	width := 0
+	graphemeClusters(s, func(cluster string) {
+		width += clusterWidth(cluster)
+	})
+	return width
+}
+
+// clusterWidth returns the display width of a single grapheme cluster.
+func clusterWidth(cluster string) int {
+	first, size := utf8.DecodeRuneInString(cluster)
+	switch gb := graphemeBreakOf(first); {
+	case gb == gbControl || gb == gbCR || gb == gbLF:
+		return 0
+	case gb == gbRegionalIndicator:
+		return 2
+	case gb == gbExtend || gb == gbZWJ:
+		// a combining mark without a base character.
+		return 0
+	}
+
+	if unicode.Is(eastAsianWide, first) {
+		return 2
+	}
+
+	// the emoji presentation selector turns a text pictograph into a wide emoji.
+	if unicode.Is(extendedPictographic, first) {
+		for _, r := range cluster[size:] {
+			if r == 0xFE0F {
+				return 2
+			}
+		}
+	}
+	return 1
+}
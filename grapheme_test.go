@@ -0,0 +1,61 @@
+package goval
+
+import "testing"
+
+func TestGraphemeCount(t *testing.T) {
+	tests := []struct {
+		desc  string
+		input string
+		exp   int
+	}{
+		{desc: "empty", input: "", exp: 0},
+		{desc: "ascii", input: "abc", exp: 3},
+		{desc: "precomposed diacritics", input: "Désï", exp: 4},
+		{desc: "combining diacritics", input: "De\u0301si\u0308", exp: 4},
+		{desc: "CR LF", input: "a\r\nb", exp: 3},
+		{desc: "flag", input: "🇮🇩", exp: 1},
+		{desc: "two flags", input: "🇮🇩🇯🇵", exp: 2},
+		{desc: "odd regional indicators", input: "🇮🇩🇯", exp: 2},
+		{desc: "skin tone", input: "👍🏽", exp: 1},
+		{desc: "ZWJ family", input: "👨‍👩‍👧", exp: 1},
+		{desc: "emoji presentation", input: "❤️", exp: 1},
+		{desc: "three emoji", input: "😀😃😄", exp: 3},
+		{desc: "Hangul syllables", input: "한국", exp: 2},
+		{desc: "Hangul jamo", input: "\u1112\u1161\u11AB", exp: 1},
+		{desc: "Devanagari spacing mark", input: "कि", exp: 1},
+		{desc: "control characters are separated", input: "a\u0000\u0301", exp: 3},
+		{desc: "tag sequence flag", input: "🏴\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F", exp: 1},
+	}
+
+	for _, tc := range tests {
+		if got := graphemeCount(tc.input); got != tc.exp {
+			t.Errorf("%s: expect %d graphemes; got %d", tc.desc, tc.exp, got)
+		}
+	}
+}
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		desc  string
+		input string
+		exp   int
+	}{
+		{desc: "ascii", input: "abc", exp: 3},
+		{desc: "combining marks", input: "e\u0301", exp: 1},
+		{desc: "CJK", input: "日本語", exp: 6},
+		{desc: "Hangul", input: "한국", exp: 4},
+		{desc: "fullwidth", input: "ＡＢ", exp: 4},
+		{desc: "emoji", input: "😀", exp: 2},
+		{desc: "ZWJ sequence", input: "👨‍👩‍👧", exp: 2},
+		{desc: "flag", input: "🇮🇩", exp: 2},
+		{desc: "text heart", input: "❤", exp: 1},
+		{desc: "emoji heart", input: "❤️", exp: 2},
+		{desc: "control", input: "a\tb", exp: 2},
+	}
+
+	for _, tc := range tests {
+		if got := displayWidth(tc.input); got != tc.exp {
+			t.Errorf("%s: expect width %d; got %d", tc.desc, tc.exp, got)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Package digits provides the ASCII digit helpers shared by the checksum rules of goval and its subpackages.
+package digits
+
+import "strings"
+
+// Valid reports whether s is not empty and only contains ASCII digits.
+func Valid(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Strip removes every byte of the separators from s.
+func Strip(s, separators string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(separators, r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// Atoi converts the ASCII digits to an int, the caller ensures the digits are valid.
+func Atoi(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		n = n*10 + int(s[i]-'0')
+	}
+	return n
+}
+
+// Luhn reports whether the digits have a valid Luhn check digit at the end.
+func Luhn(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
@@ -0,0 +1,32 @@
+// Package rulecode provides the rule code type of the goval subpackages.
+package rulecode
+
+import (
+	"strings"
+
+	"github.com/pkg-id/goval"
+)
+
+// Code is a rule code whose value is its registered ID, e.g. "govalid.nik", so it is written in JSON as the ID.
+type Code string
+
+// Equal implements goval.RuleCoder.
+func (c Code) Equal(other goval.RuleCoder) bool {
+	v, ok := other.(Code)
+	return ok && c == v
+}
+
+// String implements fmt.Stringer.
+func (c Code) String() string { return string(c) }
+
+// Info returns the RuleCodeInfo of the code, its ID is the code itself and the namespace is taken from the ID.
+func Info(code Code, argNames ...string) goval.RuleCodeInfo {
+	namespace, _, _ := strings.Cut(string(code), ".")
+	return goval.RuleCodeInfo{
+		Code:      code,
+		Namespace: namespace,
+		ID:        string(code),
+		Arity:     len(argNames),
+		ArgNames:  argNames,
+	}
+}
@@ -0,0 +1,49 @@
+// Package ruletest provides the table test of the string rules of the goval subpackages.
+package ruletest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/pkg-id/goval"
+)
+
+// Case is a test case of a rule, the Code is nil if the input is valid.
+// The Args are only compared if they are not nil.
+type Case struct {
+	Desc  string
+	Input string
+	Code  goval.RuleCoder
+	Args  []any
+}
+
+// Run validates each input by the validator and checks the code and the args of the RuleError.
+func Run(t *testing.T, validator goval.RuleValidator[string], tests []Case) {
+	t.Helper()
+	ctx := context.Background()
+	for _, tc := range tests {
+		err := validator.Validate(ctx, tc.Input)
+		if tc.Code == nil {
+			if err != nil {
+				t.Errorf("%s: expect no error; got error: %v", tc.Desc, err)
+			}
+			continue
+		}
+
+		var exp *goval.RuleError
+		if !errors.As(err, &exp) {
+			t.Errorf("%s: expect error type: %T; got error type: %T", tc.Desc, exp, err)
+			continue
+		}
+
+		if !exp.Code.Equal(tc.Code) {
+			t.Errorf("%s: expect the error code: %v; got error code: %v", tc.Desc, tc.Code, exp.Code)
+		}
+
+		if tc.Args != nil && !reflect.DeepEqual(exp.Args, tc.Args) {
+			t.Errorf("%s: expect the error args: %v; got error args: %v", tc.Desc, tc.Args, exp.Args)
+		}
+	}
+}
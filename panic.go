@@ -0,0 +1,90 @@
+package goval
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"strings"
+)
+
+type contextType struct {
+	name string
+}
+
+var repanicContext = contextType{name: "repanic"}
+
+// ContextWithRepanic returns a context that makes the validators re-panic instead of recovering the panic
+// into an InternalError. It is intended for tests, where a crash with the original stack is preferred.
+func ContextWithRepanic(ctx context.Context) context.Context {
+	return context.WithValue(ctx, repanicContext, true)
+}
+
+// repanicFromContext reports whether the panics should be propagated.
+func repanicFromContext(ctx context.Context) bool {
+	repanic, _ := ctx.Value(repanicContext).(bool)
+	return repanic
+}
+
+// protect calls fn, which runs the rule, and turns a panic into an InternalError.
+func protect[R any](ctx context.Context, rule R, fn func() error) (err error) {
+	defer recoverPanic(ctx, &err, &rule)
+	return fn()
+}
+
+// recoverPanic recovers a panic of the rule and stores it as an InternalError into err.
+// It must be called directly by defer, otherwise the panic is not recovered. The rule is read when
+// the panic is recovered, so the caller may point it to the rule that is running.
+func recoverPanic[R any](ctx context.Context, err *error, rule *R) {
+	rec := recover()
+	if rec == nil {
+		return
+	}
+
+	if repanicFromContext(ctx) {
+		panic(rec)
+	}
+
+	*err = newPanicError(rec, debug.Stack(), ruleName(*rule))
+}
+
+// ruleName returns the name of the rule for InternalError.Rule. It is the name of the function
+// without the package path, e.g. "goval.SVV[...].Min.func1", or the type name if the rule is not a function.
+func ruleName(rule any) string {
+	v := reflect.ValueOf(rule)
+	switch {
+	case !v.IsValid():
+		return ""
+	case v.Kind() != reflect.Func:
+		return fmt.Sprintf("%T", rule)
+	case v.IsNil():
+		return ""
+	}
+
+	fn := runtime.FuncForPC(v.Pointer())
+	if fn == nil {
+		return ""
+	}
+
+	name := fn.Name()
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+// newPanicError creates an InternalError from the recovered value and its stack trace.
+// If the recovered value is an error, it is wrapped, so it can be inspected by errors.Is and errors.As.
+func newPanicError(rec any, stack []byte, rule string) *InternalError {
+	var err error
+	if e, ok := rec.(error); ok {
+		err = fmt.Errorf("panic: %w", e)
+	} else {
+		err = fmt.Errorf("panic: %v", rec)
+	}
+
+	return &InternalError{
+		Err:       err,
+		Recovered: rec,
+		Stack:     stack,
+		Rule:      rule,
+	}
+}
@@ -0,0 +1,114 @@
+package goval_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pkg-id/goval"
+	"github.com/pkg-id/goval/govalregex"
+)
+
+type panicTestAddress struct {
+	City *string
+}
+
+func TestExecute_Panic(t *testing.T) {
+	addressValidator := func(ctx context.Context, a panicTestAddress) error {
+		return goval.Execute(ctx,
+			goval.Named("city", *a.City, goval.String().Required()),
+		)
+	}
+
+	t.Run("recovered with the path", func(t *testing.T) {
+		ctx := context.Background()
+		err := goval.Execute(ctx,
+			goval.Named("name", "bob", goval.String().Required()),
+			goval.Named("address", panicTestAddress{}, goval.Use(addressValidator)),
+		)
+
+		var ie *goval.InternalError
+		if !errors.As(err, &ie) {
+			t.Fatalf("expect error type: %T; got error type: %T", ie, err)
+		}
+
+		if !reflect.DeepEqual(ie.Path, []string{"address"}) {
+			t.Errorf("expect the path is [address]; got %v", ie.Path)
+		}
+
+		if ie.Recovered == nil || len(ie.Stack) == 0 {
+			t.Errorf("expect the recovered value and the stack are kept; got %v", ie)
+		}
+
+		if ie.Rule != "goval_test.TestExecute_Panic.func1" {
+			t.Errorf("expect the rule is the address validator; got %q", ie.Rule)
+		}
+
+		if !strings.HasPrefix(ie.Error(), "goval.InternalError: address: rule goval_test.TestExecute_Panic.func1: panic:") {
+			t.Errorf("unexpected error message: %v", ie)
+		}
+	})
+
+	t.Run("recovered with the rule of the chain", func(t *testing.T) {
+		validator := goval.String().Required().Match(govalregex.Compile("[")).Max(10)
+		err := goval.Named("code", "abc", validator).Validate(context.Background())
+
+		var ie *goval.InternalError
+		if !errors.As(err, &ie) {
+			t.Fatalf("expect error type: %T; got error type: %T", ie, err)
+		}
+
+		if !reflect.DeepEqual(ie.Path, []string{"code"}) {
+			t.Errorf("expect the path is [code]; got %v", ie.Path)
+		}
+
+		if !strings.Contains(ie.Rule, ".Match.") {
+			t.Errorf("expect the rule is the Match rule; got %q", ie.Rule)
+		}
+	})
+
+	t.Run("recovered error value is wrapped", func(t *testing.T) {
+		boom := errors.New("boom")
+		custom := func(ctx context.Context, value int) error { panic(boom) }
+
+		err := goval.Execute(context.Background(), goval.Bind[int](1, goval.Use(custom)))
+		if !errors.Is(err, boom) {
+			t.Errorf("expect the panic value is wrapped; got %v", err)
+		}
+	})
+
+	t.Run("re-panic", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("expect panic; got no panic")
+			}
+		}()
+
+		ctx := goval.ContextWithRepanic(context.Background())
+		_ = goval.Execute(ctx, goval.Named("address", panicTestAddress{}, goval.Use(addressValidator)))
+	})
+}
+
+func TestNamed_InternalErrorIsNotMutated(t *testing.T) {
+	ie := goval.NewInternalError(errors.New("internal error"))
+	custom := func(ctx context.Context, value string) error { return ie }
+
+	for i := 0; i < 2; i++ {
+		err := goval.Named("field-name", "a", goval.String().With(custom)).Validate(context.Background())
+
+		var got *goval.InternalError
+		if !errors.As(err, &got) {
+			t.Fatalf("expect error type: %T; got error type: %T", got, err)
+		}
+
+		if !reflect.DeepEqual(got.Path, []string{"field-name"}) {
+			t.Errorf("expect the path is [field-name]; got %v", got.Path)
+		}
+	}
+
+	if ie.Path != nil {
+		t.Errorf("expect the returned error is not mutated; got %v", ie.Path)
+	}
+}
@@ -39,7 +39,7 @@ func (f PtrValidator[T]) Optional(validator RuleValidator[T]) PtrValidator[T] {
 }
 
 // Then chains the given validator to the current validator.
-// It will be panic if the value of T is nil.
+// It will be panic if the value of T is nil, the panic is recovered as an InternalError by the chain.
 // Use Optional to optionally validate the value.
 func (f PtrValidator[T]) Then(validator RuleValidator[T]) PtrValidator[T] {
 	return Chain(f, func(ctx context.Context, value *T) error {
@@ -92,14 +92,28 @@ func TestPtrValidator_Then(t *testing.T) {
 }
 
 func TestPtrValidator_ThenPanic(t *testing.T) {
-	defer func() {
-		if r := recover(); r == nil {
-			t.Errorf("expect panic; got no panic")
-		}
-	}()
-
 	sv := goval.String().Required()
-	_ = goval.Ptr[string]().Then(sv).Validate(context.Background(), nil)
+	err := goval.Ptr[string]().Then(sv).Validate(context.Background(), nil)
+
+	var exp *goval.InternalError
+	if !errors.As(err, &exp) {
+		t.Fatalf("expect error type: %T; got error type: %T", exp, err)
+	}
+
+	if exp.Recovered == nil {
+		t.Errorf("expect the recovered value is kept")
+	}
+
+	t.Run("re-panic", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("expect panic; got no panic")
+			}
+		}()
+
+		ctx := goval.ContextWithRepanic(context.Background())
+		_ = goval.Ptr[string]().Then(sv).Validate(ctx, nil)
+	})
 }
 
 func BenchmarkPtrValidator_Required(b *testing.B) {
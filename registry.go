@@ -0,0 +1,255 @@
+package goval
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	ErrRuleCodeInvalid  = TextError("rule code is invalid")
+	ErrRuleCodeConflict = TextError("rule code conflicts with a registered rule code")
+	ErrRuleCodeUnknown  = TextError("rule code is not registered")
+)
+
+// RuleCodeInfo describes a registered rule code.
+type RuleCodeInfo struct {
+	Code      RuleCoder // the code that is returned by the rule.
+	Namespace string    // the group of the code, e.g. "strings".
+	ID        string    // the stable string ID prefixed by the namespace, e.g. "strings.min".
+	Arity     int       // the number of args of the RuleError.
+	ArgNames  []string  // the names of the args, in the same order as the args.
+}
+
+// RuleCodeFormat determines how the rule codes are written in the JSON output.
+type RuleCodeFormat int
+
+const (
+	// RuleCodeFormatNumeric writes the code as it is marshaled by itself, the built-in codes are numbers.
+	RuleCodeFormatNumeric RuleCodeFormat = iota
+	// RuleCodeFormatString writes the stable string ID of the registered codes.
+	RuleCodeFormatString
+)
+
+// ruleCodeRegistry holds the registered rule codes, indexed by the ID and the JSON representation.
+// The codes are not used as the map keys, since a RuleCoder may have a dynamic type that is not hashable.
+type ruleCodeRegistry struct {
+	mu     sync.RWMutex
+	infos  []RuleCodeInfo
+	byID   map[string]int
+	byJSON map[string]int
+}
+
+var registry = ruleCodeRegistry{
+	byID:   make(map[string]int),
+	byJSON: make(map[string]int),
+}
+
+var globalRuleCodeFormat = RuleCodeFormatNumeric
+var globalRuleCodeFormatLock sync.RWMutex
+
+// SetRuleCodeFormat sets how the rule codes are written in the JSON output of RuleError.
+// The codes that are not registered are always written as they are.
+func SetRuleCodeFormat(format RuleCodeFormat) {
+	globalRuleCodeFormatLock.Lock()
+	defer globalRuleCodeFormatLock.Unlock()
+	globalRuleCodeFormat = format
+}
+
+func ruleCodeFormat() RuleCodeFormat {
+	globalRuleCodeFormatLock.RLock()
+	defer globalRuleCodeFormatLock.RUnlock()
+	return globalRuleCodeFormat
+}
+
+// RegisterRuleCode registers a rule code, so it can be translated, written as a string ID and decoded from JSON.
+// It returns ErrRuleCodeConflict if the code, its ID or its JSON representation is already registered.
+func RegisterRuleCode(info RuleCodeInfo) error {
+	if err := info.validate(); err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(info.Code)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrRuleCodeInvalid, err)
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if _, ok := registry.byID[info.ID]; ok {
+		return fmt.Errorf("%w: id %q", ErrRuleCodeConflict, info.ID)
+	}
+
+	if _, ok := registry.byJSON[string(raw)]; ok {
+		return fmt.Errorf("%w: code %s", ErrRuleCodeConflict, raw)
+	}
+
+	// the string codes are written in the same way as the IDs, so both must not be ambiguous.
+	if idx, ok := registry.byJSON[string(marshalJSONValue(info.ID))]; ok {
+		return fmt.Errorf("%w: id %q is the code of %q", ErrRuleCodeConflict, info.ID, registry.infos[idx].ID)
+	}
+
+	var id string
+	if json.Unmarshal(raw, &id) == nil {
+		if _, ok := registry.byID[id]; ok {
+			return fmt.Errorf("%w: code %s is a registered id", ErrRuleCodeConflict, raw)
+		}
+	}
+
+	for _, registered := range registry.infos {
+		if registered.Code.Equal(info.Code) || info.Code.Equal(registered.Code) {
+			return fmt.Errorf("%w: code %v equals to %q", ErrRuleCodeConflict, info.Code, registered.ID)
+		}
+	}
+
+	info.ArgNames = append([]string(nil), info.ArgNames...)
+	idx := len(registry.infos)
+	registry.infos = append(registry.infos, info)
+	registry.byID[info.ID] = idx
+	registry.byJSON[string(raw)] = idx
+	return nil
+}
+
+// MustRegisterRuleCode is like RegisterRuleCode but panics if any of the codes cannot be registered.
+// It simplifies registering the codes in the init function.
+func MustRegisterRuleCode(infos ...RuleCodeInfo) {
+	for _, info := range infos {
+		if err := RegisterRuleCode(info); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// LookupRuleCode returns the registered info of the given code.
+// The code is looked up by its JSON representation, then compared by RuleCoder.Equal.
+func LookupRuleCode(code RuleCoder) (RuleCodeInfo, bool) {
+	if code == nil {
+		return RuleCodeInfo{}, false
+	}
+
+	raw, err := json.Marshal(code)
+	if err != nil {
+		return RuleCodeInfo{}, false
+	}
+
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	idx, ok := registry.byJSON[string(raw)]
+	if !ok || !registry.infos[idx].Code.Equal(code) {
+		return RuleCodeInfo{}, false
+	}
+	return registry.infos[idx], true
+}
+
+// LookupRuleCodeID returns the registered info of the given string ID.
+func LookupRuleCodeID(id string) (RuleCodeInfo, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	idx, ok := registry.byID[id]
+	if !ok {
+		return RuleCodeInfo{}, false
+	}
+	return registry.infos[idx], true
+}
+
+// RuleCodes returns all the registered rule codes, sorted by the ID.
+func RuleCodes() []RuleCodeInfo {
+	registry.mu.RLock()
+	infos := append([]RuleCodeInfo(nil), registry.infos...)
+	registry.mu.RUnlock()
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID < infos[j].ID })
+	return infos
+}
+
+// validate checks the info is complete and consistent.
+func (info RuleCodeInfo) validate() error {
+	switch {
+	case info.Code == nil:
+		return fmt.Errorf("%w: code is nil", ErrRuleCodeInvalid)
+	case info.Namespace == "" || strings.Contains(info.Namespace, "."):
+		return fmt.Errorf("%w: namespace %q", ErrRuleCodeInvalid, info.Namespace)
+	case !strings.HasPrefix(info.ID, info.Namespace+".") || len(info.ID) == len(info.Namespace)+1:
+		return fmt.Errorf("%w: id %q is not in namespace %q", ErrRuleCodeInvalid, info.ID, info.Namespace)
+	case info.Arity < 0 || len(info.ArgNames) != info.Arity:
+		return fmt.Errorf("%w: id %q has arity %d with %d arg names", ErrRuleCodeInvalid, info.ID, info.Arity, len(info.ArgNames))
+	}
+	return nil
+}
+
+// marshalRuleCode returns the JSON value of the code according to the active RuleCodeFormat.
+func marshalRuleCode(code RuleCoder) any {
+	if ruleCodeFormat() == RuleCodeFormatString {
+		if info, ok := LookupRuleCode(code); ok {
+			return info.ID
+		}
+	}
+	return code
+}
+
+// RuleCoderDecoder resolves the JSON representation of a rule code back into a RuleCoder.
+// It reports false if the raw value is not a code it knows about.
+type RuleCoderDecoder func(raw json.RawMessage) (RuleCoder, bool)
+
+var ruleCoderDecoders []RuleCoderDecoder
+var ruleCoderDecodersLock sync.RWMutex
+
+// RegisterRuleCoderDecoder registers a decoder for the RuleCoder implementations that are not registered
+// by RegisterRuleCode. The decoders are consulted in the order they are registered, after the registered codes.
+func RegisterRuleCoderDecoder(decoder RuleCoderDecoder) {
+	ruleCoderDecodersLock.Lock()
+	defer ruleCoderDecodersLock.Unlock()
+	ruleCoderDecoders = append(ruleCoderDecoders, decoder)
+}
+
+// DecodeRuleCode resolves the JSON representation of a rule code, either numeric or string ID,
+// by using the registered codes and the registered decoders.
+func DecodeRuleCode(raw json.RawMessage) (RuleCoder, error) {
+	raw = bytes.TrimSpace(raw)
+	if code, ok := decodeRegisteredRuleCode(raw); ok {
+		return code, nil
+	}
+
+	ruleCoderDecodersLock.RLock()
+	defer ruleCoderDecodersLock.RUnlock()
+	for _, decode := range ruleCoderDecoders {
+		if code, ok := decode(raw); ok {
+			return code, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrRuleCodeUnknown, raw)
+}
+
+// decodeRegisteredRuleCode resolves the code by its string ID or by its JSON representation.
+func decodeRegisteredRuleCode(raw json.RawMessage) (RuleCoder, bool) {
+	var id string
+	if json.Unmarshal(raw, &id) == nil {
+		if info, ok := LookupRuleCodeID(id); ok {
+			return info.Code, true
+		}
+	}
+
+	// normalize the JSON representation, e.g. 2001.0 or "a" are the same values.
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, false
+	}
+
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	idx, ok := registry.byJSON[string(marshalJSONValue(v))]
+	if !ok {
+		return nil, false
+	}
+	return registry.infos[idx].Code, true
+}
+
+// marshalJSONValue marshals the value, it never fails for the strings and the decoded JSON values.
+func marshalJSONValue(v any) []byte {
+	b, _ := json.Marshal(v)
+	return b
+}
@@ -0,0 +1,127 @@
+package goval_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/pkg-id/goval"
+)
+
+type registryTestCode int
+
+func (c registryTestCode) Equal(other goval.RuleCoder) bool {
+	v, ok := other.(registryTestCode)
+	return ok && c == v
+}
+
+func (c registryTestCode) String() string { return "registryTestCode" }
+
+func TestRegisterRuleCode(t *testing.T) {
+	t.Run("register and lookup", func(t *testing.T) {
+		info := goval.RuleCodeInfo{
+			Code:      registryTestCode(90_001),
+			Namespace: "registry_test",
+			ID:        "registry_test.has_prefix",
+			Arity:     1,
+			ArgNames:  []string{"prefix"},
+		}
+		if err := goval.RegisterRuleCode(info); err != nil {
+			t.Fatalf("expect no error; got error: %v", err)
+		}
+
+		got, ok := goval.LookupRuleCode(registryTestCode(90_001))
+		if !ok || got.ID != info.ID {
+			t.Errorf("expect the code is registered as %q; got %v", info.ID, got)
+		}
+
+		got, ok = goval.LookupRuleCodeID("registry_test.has_prefix")
+		if !ok || !got.Code.Equal(registryTestCode(90_001)) {
+			t.Errorf("expect the id is registered as %v; got %v", info.Code, got)
+		}
+	})
+
+	t.Run("built-in codes are registered", func(t *testing.T) {
+		info, ok := goval.LookupRuleCode(goval.StringMin)
+		if !ok {
+			t.Fatalf("expect %v is registered", goval.StringMin)
+		}
+
+		if info.ID != "strings.min" || info.Namespace != "strings" || info.Arity != 1 || info.ArgNames[0] != "min" {
+			t.Errorf("unexpected info: %+v", info)
+		}
+	})
+
+	tests := []struct {
+		desc string
+		info goval.RuleCodeInfo
+		exp  error
+	}{
+		{
+			desc: "duplicate id",
+			info: goval.RuleCodeInfo{Code: registryTestCode(90_002), Namespace: "strings", ID: "strings.min"},
+			exp:  goval.ErrRuleCodeConflict,
+		},
+		{
+			desc: "collides with the numeric built-in code",
+			info: goval.RuleCodeInfo{Code: registryTestCode(goval.StringMin), Namespace: "registry_test", ID: "registry_test.min"},
+			exp:  goval.ErrRuleCodeConflict,
+		},
+		{
+			desc: "string code collides with a registered id",
+			info: goval.RuleCodeInfo{Code: testRuleCode("strings.max"), Namespace: "registry_test", ID: "registry_test.max"},
+			exp:  goval.ErrRuleCodeConflict,
+		},
+		{
+			desc: "id outside the namespace",
+			info: goval.RuleCodeInfo{Code: registryTestCode(90_003), Namespace: "registry_test", ID: "other.rule"},
+			exp:  goval.ErrRuleCodeInvalid,
+		},
+		{
+			desc: "arity does not match the arg names",
+			info: goval.RuleCodeInfo{Code: registryTestCode(90_004), Namespace: "registry_test", ID: "registry_test.arity", Arity: 2},
+			exp:  goval.ErrRuleCodeInvalid,
+		},
+		{
+			desc: "nil code",
+			info: goval.RuleCodeInfo{Namespace: "registry_test", ID: "registry_test.nil"},
+			exp:  goval.ErrRuleCodeInvalid,
+		},
+	}
+
+	for _, tc := range tests {
+		err := goval.RegisterRuleCode(tc.info)
+		if !errors.Is(err, tc.exp) {
+			t.Errorf("%s: expect error %v; got %v", tc.desc, tc.exp, err)
+		}
+	}
+}
+
+func TestSetRuleCodeFormat(t *testing.T) {
+	goval.SetRuleCodeFormat(goval.RuleCodeFormatString)
+	t.Cleanup(func() { goval.SetRuleCodeFormat(goval.RuleCodeFormatNumeric) })
+
+	err := goval.NewKeyError("name", goval.NewRuleError(goval.StringMin, 3))
+	exp := `{"key":"name","err":{"code":"strings.min","args":[3],"params":{"min":3}}}`
+	if got := err.Error(); got != exp {
+		t.Errorf("expect %s; got %s", exp, got)
+	}
+
+	var decoded goval.KeyError
+	if err := json.Unmarshal([]byte(exp), &decoded); err != nil {
+		t.Fatalf("expect no error; got error: %v", err)
+	}
+
+	if !errors.Is(&decoded, goval.NewRuleError(goval.StringMin)) {
+		t.Errorf("expect the string id is decoded to %v; got %v", goval.StringMin, decoded.Err)
+	}
+}
+
+func TestRuleCodes(t *testing.T) {
+	infos := goval.RuleCodes()
+	for i := 1; i < len(infos); i++ {
+		if infos[i-1].ID >= infos[i].ID {
+			t.Fatalf("expect the codes are sorted by id; got %q before %q", infos[i-1].ID, infos[i].ID)
+		}
+	}
+}
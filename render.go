@@ -0,0 +1,290 @@
+package goval
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// RenderMode determines the layout of the rendered errors.
+type RenderMode int
+
+const (
+	// RenderTree renders the errors as an indented tree, nested by the keys.
+	RenderTree RenderMode = iota
+	// RenderTable renders the errors as an aligned table of path, code and message.
+	RenderTable
+)
+
+// RenderOptions configures Render.
+type RenderOptions struct {
+	Mode  RenderMode // the layout, the default is RenderTree.
+	Color bool       // colors the output with the ANSI escape codes.
+	Width int        // truncates each line to the given number of characters, zero means unlimited.
+}
+
+// ANSI escape codes used by the renderer.
+const (
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+	ansiRed    = "\x1b[31m"
+	ansiYellow = "\x1b[33m"
+	ansiCyan   = "\x1b[36m"
+)
+
+// segment is a part of a rendered line with its color.
+type segment struct {
+	text  string
+	color string
+}
+
+// line is a rendered line, the segments are joined without a separator.
+type line []segment
+
+// Render writes a human-readable representation of the error tree to w.
+// It is intended for CLI tools, logs and test failure output, the Error method is still the JSON representation.
+func Render(w io.Writer, err error, opts RenderOptions) error {
+	lines := renderLines(err, opts)
+	if len(lines) == 0 {
+		return nil
+	}
+	_, e := io.WriteString(w, strings.Join(lines, "\n")+"\n")
+	return e
+}
+
+// RenderString is like Render but returns the result as a string.
+func RenderString(err error, opts RenderOptions) string {
+	var sb strings.Builder
+	_ = Render(&sb, err, opts)
+	return sb.String()
+}
+
+// renderLines renders the error into lines according to the options.
+func renderLines(err error, opts RenderOptions) []string {
+	if err == nil {
+		return nil
+	}
+
+	var lines []line
+	switch opts.Mode {
+	default:
+		lines = renderTree(nil, err, "")
+	case RenderTable:
+		lines = renderTable(err)
+	}
+
+	out := make([]string, 0, len(lines))
+	for _, l := range lines {
+		out = append(out, l.truncate(opts.Width).String(opts.Color))
+	}
+	return out
+}
+
+// renderTree renders the error as a tree, the first line is prefixed by the given head.
+func renderTree(head line, err error, indent string) []line {
+	switch et := err.(type) {
+	case Errors:
+		var lines []line
+		if head != nil {
+			lines = append(lines, head)
+			indent += "  "
+		}
+
+		for i, e := range et {
+			var h line
+			if _, ok := e.(Errors); ok {
+				h = line{{text: indent}, {text: fmt.Sprintf("[%d]:", i), color: ansiCyan}}
+			}
+			lines = append(lines, renderTree(h, e, indent)...)
+		}
+		return lines
+	case *KeyError:
+		h := line{{text: indent}, {text: et.Key + ":", color: ansiBold}}
+		if head != nil {
+			return append([]line{head}, renderTree(h, et.Err, indent+"  ")...)
+		}
+		return renderTree(h, et.Err, indent)
+	default:
+		l := append(line{}, head...)
+		if head == nil {
+			l = append(l, segment{text: indent})
+		} else {
+			l = append(l, segment{text: " "})
+		}
+		return []line{append(l, renderLeaf(err)...)}
+	}
+}
+
+// renderLeaf renders the code and the message of a leaf error.
+func renderLeaf(err error) line {
+	code, message := leafCodeMessage(err)
+	if code == "" {
+		return line{{text: message, color: ansiRed}}
+	}
+
+	l := line{{text: "[" + code + "]", color: ansiYellow}}
+	if message != "" {
+		l = append(l, segment{text: " "}, segment{text: message, color: ansiRed})
+	}
+	return l
+}
+
+// leafCodeMessage returns the code and the message of a leaf error.
+// The code of a RuleError is its registered ID, and the message is its args.
+func leafCodeMessage(err error) (string, string) {
+	ruleErr, ok := err.(*RuleError)
+	if !ok {
+		return "", err.Error()
+	}
+
+	if ruleErr.Code == nil {
+		return "", formatArgs(nil, ruleErr.Args)
+	}
+
+	info, ok := LookupRuleCode(ruleErr.Code)
+	if !ok {
+		return ruleErr.Code.String(), formatArgs(nil, ruleErr.Args)
+	}
+	return info.ID, formatArgs(info.ArgNames, ruleErr.Args)
+}
+
+// formatArgs formats the args, each arg is prefixed by its name if it is known.
+func formatArgs(names []string, args []any) string {
+	parts := make([]string, 0, len(args))
+	for i, arg := range args {
+		if i < len(names) {
+			parts = append(parts, fmt.Sprintf("%s=%v", names[i], arg))
+		} else {
+			parts = append(parts, fmt.Sprintf("%v", arg))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// tableRow is a flattened leaf error.
+type tableRow struct {
+	path, code, message string
+}
+
+// renderTable renders the error as an aligned table with a header.
+func renderTable(err error) []line {
+	rows := flattenRows(nil, "", err)
+	header := tableRow{path: "PATH", code: "CODE", message: "MESSAGE"}
+
+	pathWidth, codeWidth := utf8.RuneCountInString(header.path), utf8.RuneCountInString(header.code)
+	for _, row := range rows {
+		if n := utf8.RuneCountInString(row.path); n > pathWidth {
+			pathWidth = n
+		}
+		if n := utf8.RuneCountInString(row.code); n > codeWidth {
+			codeWidth = n
+		}
+	}
+
+	pad := func(s string, width int) segment {
+		return segment{text: strings.Repeat(" ", width-utf8.RuneCountInString(s)+2)}
+	}
+
+	lines := []line{{
+		{text: header.path, color: ansiBold}, pad(header.path, pathWidth),
+		{text: header.code, color: ansiBold}, pad(header.code, codeWidth),
+		{text: header.message, color: ansiBold},
+	}}
+	for _, row := range rows {
+		lines = append(lines, line{
+			{text: row.path, color: ansiCyan}, pad(row.path, pathWidth),
+			{text: row.code, color: ansiYellow}, pad(row.code, codeWidth),
+			{text: row.message, color: ansiRed},
+		})
+	}
+	return lines
+}
+
+// flattenRows flattens the error tree into rows, the path is built from the keys and the positions
+// of the nested Errors, the same as the [i] headers of the tree.
+func flattenRows(rows []tableRow, path string, err error) []tableRow {
+	switch et := err.(type) {
+	case Errors:
+		for i, e := range et {
+			p := path
+			if _, ok := e.(Errors); ok {
+				p = fmt.Sprintf("%s[%d]", path, i)
+			}
+			rows = flattenRows(rows, p, e)
+		}
+		return rows
+	case *KeyError:
+		p := et.Key
+		if path != "" {
+			p = path + "." + et.Key
+		}
+		return flattenRows(rows, p, et.Err)
+	default:
+		code, message := leafCodeMessage(err)
+		return append(rows, tableRow{path: path, code: code, message: message})
+	}
+}
+
+// truncate cuts the line to the given number of characters, the last character is replaced by an ellipsis.
+func (l line) truncate(width int) line {
+	if width <= 0 {
+		return l
+	}
+
+	total := 0
+	for _, s := range l {
+		total += utf8.RuneCountInString(s.text)
+	}
+	if total <= width {
+		return l
+	}
+
+	out := make(line, 0, len(l))
+	remaining := width - 1
+	for _, s := range l {
+		n := utf8.RuneCountInString(s.text)
+		if n <= remaining {
+			out = append(out, s)
+			remaining -= n
+			continue
+		}
+
+		runes := []rune(s.text)
+		out = append(out, segment{text: string(runes[:remaining]) + "…", color: s.color})
+		break
+	}
+	return out
+}
+
+// String joins the segments, colored by the ANSI escape codes if color is true.
+func (l line) String(color bool) string {
+	var sb strings.Builder
+	for _, s := range l {
+		if color && s.color != "" && s.text != "" {
+			sb.WriteString(s.color + s.text + ansiReset)
+		} else {
+			sb.WriteString(s.text)
+		}
+	}
+	return strings.TrimRight(sb.String(), " ")
+}
+
+// formatError implements fmt.Formatter for the error types, the %+v verb renders the error as a tree,
+// and the other verbs are using the JSON representation.
+func formatError(s fmt.State, verb rune, err jsonErrorStringer) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			_, _ = io.WriteString(s, strings.Join(renderLines(err, RenderOptions{}), "\n"))
+			return
+		}
+		_, _ = io.WriteString(s, err.String())
+	case 's':
+		_, _ = io.WriteString(s, err.String())
+	case 'q':
+		_, _ = fmt.Fprintf(s, "%q", err.String())
+	default:
+		_, _ = fmt.Fprintf(s, "%%!%c(%s)", verb, err.String())
+	}
+}
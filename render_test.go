@@ -0,0 +1,111 @@
+package goval_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pkg-id/goval"
+)
+
+func renderTestErrors() goval.Errors {
+	return goval.Errors{
+		goval.NewKeyError("name", goval.NewRuleError(goval.StringRequired)),
+		goval.NewKeyError("age", goval.NewRuleError(goval.NumberMin, 17)),
+		goval.NewKeyError("social_media_list", goval.Errors{
+			goval.Errors{
+				goval.NewKeyError("name", goval.TextError("This field is required.")),
+				goval.NewKeyError("link", goval.NewRuleError(goval.StringMax, 10)),
+			},
+		}),
+	}
+}
+
+func TestRender(t *testing.T) {
+	t.Run("tree", func(t *testing.T) {
+		exp := strings.Join([]string{
+			"name: [strings.required]",
+			"age: [numbers.min] min=17",
+			"social_media_list:",
+			"  [0]:",
+			"    name: This field is required.",
+			"    link: [strings.max] max=10",
+			"",
+		}, "\n")
+
+		got := goval.RenderString(renderTestErrors(), goval.RenderOptions{})
+		if got != exp {
+			t.Errorf("expect:\n%s\ngot:\n%s", exp, got)
+		}
+	})
+
+	t.Run("table", func(t *testing.T) {
+		exp := strings.Join([]string{
+			"PATH                       CODE              MESSAGE",
+			"name                       strings.required",
+			"age                        numbers.min       min=17",
+			"social_media_list[0].name                    This field is required.",
+			"social_media_list[0].link  strings.max       max=10",
+			"",
+		}, "\n")
+
+		got := goval.RenderString(renderTestErrors(), goval.RenderOptions{Mode: goval.RenderTable})
+		if got != exp {
+			t.Errorf("expect:\n%s\ngot:\n%s", exp, got)
+		}
+	})
+
+	t.Run("width", func(t *testing.T) {
+		got := goval.RenderString(renderTestErrors(), goval.RenderOptions{Width: 20})
+		for _, l := range strings.Split(strings.TrimSuffix(got, "\n"), "\n") {
+			if n := len([]rune(l)); n > 20 {
+				t.Errorf("expect the line is truncated to 20 characters; got %d: %q", n, l)
+			}
+		}
+
+		if !strings.Contains(got, "social_media_list:\n") || !strings.Contains(got, "name: This fiel…") {
+			t.Errorf("unexpected truncated output:\n%s", got)
+		}
+	})
+
+	t.Run("color", func(t *testing.T) {
+		err := goval.NewKeyError("name", goval.NewRuleError(goval.StringRequired))
+		exp := "\x1b[1mname:\x1b[0m \x1b[33m[strings.required]\x1b[0m\n"
+		if got := goval.RenderString(err, goval.RenderOptions{Color: true}); got != exp {
+			t.Errorf("expect %q; got %q", exp, got)
+		}
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		if got := goval.RenderString(nil, goval.RenderOptions{}); got != "" {
+			t.Errorf("expect empty output; got %q", got)
+		}
+	})
+}
+
+func TestErrors_Format(t *testing.T) {
+	errs := renderTestErrors()
+
+	if got := fmt.Sprintf("%v", errs); got != errs.Error() {
+		t.Errorf("expect %%v is the JSON representation; got %s", got)
+	}
+
+	exp := strings.TrimSuffix(goval.RenderString(errs, goval.RenderOptions{}), "\n")
+	if got := fmt.Sprintf("%+v", errs); got != exp {
+		t.Errorf("expect %%+v is the tree:\n%s\ngot:\n%s", exp, got)
+	}
+
+	keyErr := goval.NewKeyError("age", goval.NewRuleError(goval.NumberMin, 17))
+	if got := fmt.Sprintf("%+v", keyErr); got != "age: [numbers.min] min=17" {
+		t.Errorf("unexpected %%+v of KeyError: %s", got)
+	}
+
+	ruleErr := goval.NewRuleError(goval.StringMin, 2)
+	if got := fmt.Sprintf("%+v", ruleErr); got != "[strings.min] min=2" {
+		t.Errorf("unexpected %%+v of RuleError: %s", got)
+	}
+
+	if got := fmt.Sprintf("%s", ruleErr); got != `{"code":2001,"args":[2],"params":{"min":2}}` {
+		t.Errorf("unexpected %%s of RuleError: %s", got)
+	}
+}
@@ -2,8 +2,8 @@ package goval
 
 import (
 	"context"
-	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/pkg-id/goval/funcs"
 )
@@ -67,22 +67,69 @@ func (f SVV[T]) Max(length int) SVV[T] {
 	})
 }
 
+// RuneMin ensures the number of runes (Unicode code points) of the string is not less than the given length.
+// Unlike Min, a multibyte character such as "é" is counted as one.
+func (f SVV[T]) RuneMin(length int) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if utf8.RuneCountInString(string(value)) < length {
+			return NewRuleError(StringRuneMin, length)
+		}
+		return nil
+	})
+}
+
+// RuneMax ensures the number of runes (Unicode code points) of the string is not greater than the given length.
+func (f SVV[T]) RuneMax(length int) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if utf8.RuneCountInString(string(value)) > length {
+			return NewRuleError(StringRuneMax, length)
+		}
+		return nil
+	})
+}
+
+// GraphemeMin ensures the number of user-perceived characters of the string is not less than the given length.
+// The characters are the extended grapheme clusters of UAX #29, for example, "e\u0301" or an emoji with
+// a skin tone or a flag are counted as one.
+func (f SVV[T]) GraphemeMin(length int) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if graphemeCount(string(value)) < length {
+			return NewRuleError(StringGraphemeMin, length)
+		}
+		return nil
+	})
+}
+
+// GraphemeMax ensures the number of user-perceived characters of the string is not greater than the given length.
+func (f SVV[T]) GraphemeMax(length int) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if graphemeCount(string(value)) > length {
+			return NewRuleError(StringGraphemeMax, length)
+		}
+		return nil
+	})
+}
+
+// DisplayWidthMax ensures the string does not occupy more than the given number of terminal columns.
+// East Asian wide characters and emoji are two columns wide, and combining marks are zero.
+func (f SVV[T]) DisplayWidthMax(width int) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if displayWidth(string(value)) > width {
+			return NewRuleError(StringDisplayWidthMax, width)
+		}
+		return nil
+	})
+}
+
 // Match ensures the string matches the given pattern.
-// If pattern cause panic, will be recovered.
+// If pattern cause panic, will be recovered as an InternalError by the chain.
 func (f SVV[T]) Match(pattern Pattern) SVV[T] {
-	return f.With(func(ctx context.Context, value T) (err error) {
-		defer func() {
-			if rec := recover(); rec != nil {
-				err = fmt.Errorf("panic: %v", rec)
-			}
-		}()
-
+	return f.With(func(ctx context.Context, value T) error {
 		exp := pattern.RegExp()
 		if !exp.MatchString(string(value)) {
 			return NewRuleError(StringMatch, exp.String())
 		}
-
-		return err
+		return nil
 	})
 }
 
@@ -0,0 +1,181 @@
+package goval
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+)
+
+// Contains ensures that the string contains the given substring.
+// This validation is case-sensitive, use ContainsFold to perform a case-insensitive Contains validation.
+func (f SVV[T]) Contains(substr T) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if !strings.Contains(string(value), string(substr)) {
+			return NewRuleError(StringContains, substr)
+		}
+		return nil
+	})
+}
+
+// ContainsFold ensures that the string contains the given substring with case-insensitivity.
+func (f SVV[T]) ContainsFold(substr T) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if !containsFoldString(string(value), string(substr)) {
+			return NewRuleError(StringContainsFold, substr)
+		}
+		return nil
+	})
+}
+
+// ContainsAny ensures that the string contains at least one of the given substrings.
+func (f SVV[T]) ContainsAny(substrs ...T) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if _, ok := findSubstring(string(value), substrs, strings.Contains); !ok {
+			return NewRuleError(StringContainsAny, substrs)
+		}
+		return nil
+	})
+}
+
+// ContainsAnyFold ensures that the string contains at least one of the given substrings with case-insensitivity.
+func (f SVV[T]) ContainsAnyFold(substrs ...T) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if _, ok := findSubstring(string(value), substrs, containsFoldString); !ok {
+			return NewRuleError(StringContainsAnyFold, substrs)
+		}
+		return nil
+	})
+}
+
+// Excludes ensures that the string does not contain the given substring.
+// This validation is case-sensitive, use ExcludesFold to perform a case-insensitive Excludes validation.
+func (f SVV[T]) Excludes(substr T) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if strings.Contains(string(value), string(substr)) {
+			return NewRuleError(StringExcludes, substr)
+		}
+		return nil
+	})
+}
+
+// ExcludesFold ensures that the string does not contain the given substring with case-insensitivity.
+func (f SVV[T]) ExcludesFold(substr T) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if containsFoldString(string(value), string(substr)) {
+			return NewRuleError(StringExcludesFold, substr)
+		}
+		return nil
+	})
+}
+
+// ExcludesAny ensures that the string contains none of the given substrings.
+// The error args is the first substring found in the string.
+func (f SVV[T]) ExcludesAny(substrs ...T) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if found, ok := findSubstring(string(value), substrs, strings.Contains); ok {
+			return NewRuleError(StringExcludesAny, found)
+		}
+		return nil
+	})
+}
+
+// ExcludesAnyFold ensures that the string contains none of the given substrings with case-insensitivity.
+// The error args is the first substring found in the string.
+func (f SVV[T]) ExcludesAnyFold(substrs ...T) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if found, ok := findSubstring(string(value), substrs, containsFoldString); ok {
+			return NewRuleError(StringExcludesAnyFold, found)
+		}
+		return nil
+	})
+}
+
+// HasPrefix ensures that the string begins with the given prefix.
+// This validation is case-sensitive, use HasPrefixFold to perform a case-insensitive HasPrefix validation.
+func (f SVV[T]) HasPrefix(prefix T) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if !strings.HasPrefix(string(value), string(prefix)) {
+			return NewRuleError(StringHasPrefix, prefix)
+		}
+		return nil
+	})
+}
+
+// HasPrefixFold ensures that the string begins with the given prefix with case-insensitivity.
+func (f SVV[T]) HasPrefixFold(prefix T) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if !hasPrefixFold(string(value), string(prefix)) {
+			return NewRuleError(StringHasPrefixFold, prefix)
+		}
+		return nil
+	})
+}
+
+// HasSuffix ensures that the string ends with the given suffix.
+// This validation is case-sensitive, use HasSuffixFold to perform a case-insensitive HasSuffix validation.
+func (f SVV[T]) HasSuffix(suffix T) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if !strings.HasSuffix(string(value), string(suffix)) {
+			return NewRuleError(StringHasSuffix, suffix)
+		}
+		return nil
+	})
+}
+
+// HasSuffixFold ensures that the string ends with the given suffix with case-insensitivity.
+func (f SVV[T]) HasSuffixFold(suffix T) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if !hasSuffixFold(string(value), string(suffix)) {
+			return NewRuleError(StringHasSuffixFold, suffix)
+		}
+		return nil
+	})
+}
+
+// findSubstring returns the first of the substrings that is contained in the string.
+func findSubstring[T ~string](s string, substrs []T, contains func(s, substr string) bool) (T, bool) {
+	for _, substr := range substrs {
+		if contains(s, string(substr)) {
+			return substr, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// hasPrefixFold is like strings.HasPrefix but compares the runes under Unicode simple case folding,
+// the same as strings.EqualFold.
+func hasPrefixFold(s, prefix string) bool {
+	n := utf8.RuneCountInString(prefix)
+	i := 0
+	for ; n > 0 && i < len(s); n-- {
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+	}
+	return n == 0 && strings.EqualFold(s[:i], prefix)
+}
+
+// hasSuffixFold is like strings.HasSuffix but compares the runes under Unicode simple case folding.
+func hasSuffixFold(s, suffix string) bool {
+	n := utf8.RuneCountInString(suffix)
+	i := len(s)
+	for ; n > 0 && i > 0; n-- {
+		_, size := utf8.DecodeLastRuneInString(s[:i])
+		i -= size
+	}
+	return n == 0 && strings.EqualFold(s[i:], suffix)
+}
+
+// containsFoldString is like strings.Contains but compares the runes under Unicode simple case folding.
+func containsFoldString(s, substr string) bool {
+	for i := 0; ; {
+		if hasPrefixFold(s[i:], substr) {
+			return true
+		}
+		if i >= len(s) {
+			return false
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+	}
+}
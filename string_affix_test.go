@@ -0,0 +1,120 @@
+package goval_test
+
+import (
+	"testing"
+
+	"github.com/pkg-id/goval"
+)
+
+func TestStringValidator_Contains(t *testing.T) {
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "contains ok", validator: goval.String().Contains("lo W"), input: "Hello World"},
+		{desc: "contains fails", validator: goval.String().Contains("lo w"), input: "Hello World", code: goval.StringContains, args: []any{"lo w"}},
+	})
+}
+
+func TestStringValidator_ContainsFold(t *testing.T) {
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "contains fold ok", validator: goval.String().ContainsFold("LO w"), input: "Hello World"},
+		{desc: "contains fold unicode", validator: goval.String().ContainsFold("ÄRGER"), input: "so ein ärger"},
+		{desc: "contains fold final sigma", validator: goval.String().ContainsFold("ΟΔΟΣ"), input: "η οδος"},
+		{desc: "contains fold unicode fails", validator: goval.String().ContainsFold("ÄRGER"), input: "so ein arger", code: goval.StringContainsFold, args: []any{"ÄRGER"}},
+		{desc: "contains fold fails", validator: goval.String().ContainsFold("xyz"), input: "Hello World", code: goval.StringContainsFold, args: []any{"xyz"}},
+		{desc: "contains fold empty", validator: goval.String().ContainsFold(""), input: ""},
+	})
+}
+
+func TestStringValidator_ContainsAny(t *testing.T) {
+	validator := goval.String().ContainsAny("@", "#")
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "contains any ok", validator: validator, input: "a#b"},
+		{
+			desc:      "contains any fails",
+			validator: validator,
+			input:     "ab",
+			code:      goval.StringContainsAny,
+			args:      []any{[]string{"@", "#"}},
+		},
+	})
+}
+
+func TestStringValidator_ContainsAnyFold(t *testing.T) {
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "contains any fold ok", validator: goval.String().ContainsAnyFold("ADMIN", "ROOT"), input: "the-root-user"},
+		{desc: "contains any fold fails", validator: goval.String().ContainsAnyFold("ADMIN"), input: "user", code: goval.StringContainsAnyFold, args: []any{[]string{"ADMIN"}}},
+	})
+}
+
+func TestStringValidator_Excludes(t *testing.T) {
+	validator := goval.String().Excludes("..")
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "excludes ok", validator: validator, input: "a/b/c"},
+		{desc: "excludes fails", validator: validator, input: "a/../c", code: goval.StringExcludes, args: []any{".."}},
+	})
+}
+
+func TestStringValidator_ExcludesFold(t *testing.T) {
+	validator := goval.String().ExcludesFold("<script")
+	runStringRuleTests(t, []stringRuleTest{
+		{
+			desc:      "excludes fold fails",
+			validator: validator,
+			input:     "<SCRIPT>",
+			code:      goval.StringExcludesFold,
+			args:      []any{"<script"},
+		},
+	})
+}
+
+func TestStringValidator_ExcludesAny(t *testing.T) {
+	validator := goval.String().ExcludesAny("<", ">")
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "excludes any ok", validator: validator, input: "plain"},
+		{desc: "excludes any fails", validator: validator, input: "a>b", code: goval.StringExcludesAny, args: []any{">"}},
+	})
+}
+
+func TestStringValidator_ExcludesAnyFold(t *testing.T) {
+	validator := goval.String().ExcludesAnyFold("drop table")
+	runStringRuleTests(t, []stringRuleTest{
+		{
+			desc:      "excludes any fold fails",
+			validator: validator,
+			input:     "x; DROP TABLE y",
+			code:      goval.StringExcludesAnyFold,
+			args:      []any{"drop table"},
+		},
+	})
+}
+
+func TestStringValidator_HasPrefix(t *testing.T) {
+	validator := goval.String().HasPrefix("sk_")
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "has prefix ok", validator: validator, input: "sk_live"},
+		{desc: "has prefix fails", validator: validator, input: "SK_live", code: goval.StringHasPrefix, args: []any{"sk_"}},
+	})
+}
+
+func TestStringValidator_HasPrefixFold(t *testing.T) {
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "has prefix fold ok", validator: goval.String().HasPrefixFold("sk_"), input: "SK_live"},
+		{desc: "has prefix fold kelvin sign", validator: goval.String().HasPrefixFold("k"), input: "\u212aelvin"},
+		{desc: "has prefix fold too short", validator: goval.String().HasPrefixFold("abc"), input: "AB", code: goval.StringHasPrefixFold, args: []any{"abc"}},
+	})
+}
+
+func TestStringValidator_HasSuffix(t *testing.T) {
+	validator := goval.String().HasSuffix(".go")
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "has suffix ok", validator: validator, input: "main.go"},
+		{desc: "has suffix fails", validator: validator, input: "main.GO", code: goval.StringHasSuffix, args: []any{".go"}},
+	})
+}
+
+func TestStringValidator_HasSuffixFold(t *testing.T) {
+	validator := goval.String().HasSuffixFold(".go")
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "has suffix fold ok", validator: validator, input: "main.GO"},
+		{desc: "has suffix fold fails", validator: validator, input: "main.rs", code: goval.StringHasSuffixFold, args: []any{".go"}},
+	})
+}
@@ -0,0 +1,156 @@
+package goval
+
+import (
+	"context"
+	"strings"
+	"unicode"
+)
+
+// Lowercase ensures that the string has no uppercase or titlecase letter, in any script,
+// e.g. "straße" is accepted but "Straße" is not. The characters without case, such as digits, are accepted.
+func (f SVV[T]) Lowercase() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if strings.IndexFunc(string(value), func(r rune) bool { return unicode.IsUpper(r) || unicode.IsTitle(r) }) >= 0 {
+			return NewRuleError(StringLowercase)
+		}
+		return nil
+	})
+}
+
+// Uppercase ensures that the string has no lowercase or titlecase letter, in any script,
+// e.g. "ΑΘΗΝΑ" is accepted but "Αθήνα" is not. The characters without case, such as digits, are accepted.
+func (f SVV[T]) Uppercase() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if strings.IndexFunc(string(value), func(r rune) bool { return unicode.IsLower(r) || unicode.IsTitle(r) }) >= 0 {
+			return NewRuleError(StringUppercase)
+		}
+		return nil
+	})
+}
+
+// Slug ensures that the string is a URL slug, words of ASCII lowercase letters and digits
+// separated by single hyphens, e.g. "2024-annual-report".
+func (f SVV[T]) Slug() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if !isSeparatedWords(string(value), '-', isLowerAlnumASCII, isLowerAlnumASCII) {
+			return NewRuleError(StringSlug)
+		}
+		return nil
+	})
+}
+
+// SnakeCase ensures that the string is in snake_case, words of ASCII lowercase letters and digits
+// separated by single underscores, starting with a letter, e.g. "created_at" or "utf8_name".
+func (f SVV[T]) SnakeCase() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if !isSeparatedWords(string(value), '_', isLowerASCII, isLowerAlnumASCII) {
+			return NewRuleError(StringSnakeCase)
+		}
+		return nil
+	})
+}
+
+// ScreamingSnake ensures that the string is in SCREAMING_SNAKE_CASE, words of ASCII uppercase letters and digits
+// separated by single underscores, starting with a letter, e.g. the environment variable names like "DATABASE_URL".
+func (f SVV[T]) ScreamingSnake() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if !isSeparatedWords(string(value), '_', isUpperASCII, isUpperAlnumASCII) {
+			return NewRuleError(StringScreamingSnake)
+		}
+		return nil
+	})
+}
+
+// CamelCase ensures that the string is in camelCase, ASCII letters and digits starting with a lowercase letter,
+// e.g. "createdAt" or "userID".
+func (f SVV[T]) CamelCase() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		s := string(value)
+		if s == "" || !isLowerASCII(s[0]) {
+			return NewRuleError(StringCamelCase)
+		}
+
+		for i := 1; i < len(s); i++ {
+			if !isLowerAlnumASCII(s[i]) && !isUpperASCII(s[i]) {
+				return NewRuleError(StringCamelCase)
+			}
+		}
+		return nil
+	})
+}
+
+// KebabCase ensures that the string is in kebab-case, words of ASCII lowercase letters and digits
+// separated by single hyphens, starting with a letter, e.g. "max-age". Unlike Slug, it must start with a letter.
+func (f SVV[T]) KebabCase() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if !isSeparatedWords(string(value), '-', isLowerASCII, isLowerAlnumASCII) {
+			return NewRuleError(StringKebabCase)
+		}
+		return nil
+	})
+}
+
+// Trimmed ensures that the string has no leading or trailing white space, as defined by unicode.IsSpace,
+// e.g. the no-break space U+00A0 is white space.
+func (f SVV[T]) Trimmed() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		s := string(value)
+		if strings.TrimFunc(s, unicode.IsSpace) != s {
+			return NewRuleError(StringTrimmed)
+		}
+		return nil
+	})
+}
+
+// SingleLine ensures that the string has no line break: line feed, carriage return, vertical tab, form feed,
+// next line U+0085, line separator U+2028 or paragraph separator U+2029.
+// It returns StringSingleLine with the byte offset of the first line break.
+func (f SVV[T]) SingleLine() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if i := strings.IndexAny(string(value), "\n\r\v\f\u0085\u2028\u2029"); i >= 0 {
+			return NewRuleError(StringSingleLine, i)
+		}
+		return nil
+	})
+}
+
+// NoControlChars ensures that the string has no control character, as defined by unicode.IsControl,
+// including the tab and the line breaks. It returns StringNoControlChars with the byte offset of the first one.
+func (f SVV[T]) NoControlChars() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if i := strings.IndexFunc(string(value), unicode.IsControl); i >= 0 {
+			return NewRuleError(StringNoControlChars, i)
+		}
+		return nil
+	})
+}
+
+// isSeparatedWords reports whether s is words separated by single separators, where the first byte
+// satisfies first and the other bytes of the words satisfy word.
+func isSeparatedWords(s string, sep byte, first, word func(c byte) bool) bool {
+	if s == "" || !first(s[0]) || s[len(s)-1] == sep {
+		return false
+	}
+
+	for i := 1; i < len(s); i++ {
+		if s[i] == sep && s[i-1] != sep {
+			continue
+		}
+		if !word(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isLowerASCII reports whether the byte is an ASCII lowercase letter.
+func isLowerASCII(c byte) bool { return 'a' <= c && c <= 'z' }
+
+// isUpperASCII reports whether the byte is an ASCII uppercase letter.
+func isUpperASCII(c byte) bool { return 'A' <= c && c <= 'Z' }
+
+// isLowerAlnumASCII reports whether the byte is an ASCII lowercase letter or digit.
+func isLowerAlnumASCII(c byte) bool { return isLowerASCII(c) || '0' <= c && c <= '9' }
+
+// isUpperAlnumASCII reports whether the byte is an ASCII uppercase letter or digit.
+func isUpperAlnumASCII(c byte) bool { return isUpperASCII(c) || '0' <= c && c <= '9' }
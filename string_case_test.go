@@ -0,0 +1,115 @@
+package goval_test
+
+import (
+	"testing"
+
+	"github.com/pkg-id/goval"
+)
+
+func TestStringValidator_Lowercase(t *testing.T) {
+	validator := goval.String().Lowercase()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "lowercase", validator: validator, input: "straße 12"},
+		{desc: "lowercase empty", validator: validator, input: ""},
+		{desc: "lowercase fails", validator: validator, input: "Straße", code: goval.StringLowercase},
+		{desc: "lowercase titlecase", validator: validator, input: "ǅemal", code: goval.StringLowercase},
+		{desc: "lowercase greek", validator: validator, input: "αθήνα"},
+	})
+}
+
+func TestStringValidator_Uppercase(t *testing.T) {
+	validator := goval.String().Uppercase()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "uppercase", validator: validator, input: "ΑΘΗΝΑ-1"},
+		{desc: "uppercase fails", validator: validator, input: "Αθήνα", code: goval.StringUppercase},
+		{desc: "uppercase caseless script", validator: validator, input: "日本"},
+	})
+}
+
+func TestStringValidator_Slug(t *testing.T) {
+	validator := goval.String().Slug()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "slug", validator: validator, input: "2024-annual-report"},
+		{desc: "slug single word", validator: validator, input: "hello"},
+		{desc: "slug empty", validator: validator, input: "", code: goval.StringSlug},
+		{desc: "slug uppercase", validator: validator, input: "Hello-world", code: goval.StringSlug},
+		{desc: "slug double hyphen", validator: validator, input: "hello--world", code: goval.StringSlug},
+		{desc: "slug leading hyphen", validator: validator, input: "-hello", code: goval.StringSlug},
+		{desc: "slug trailing hyphen", validator: validator, input: "hello-", code: goval.StringSlug},
+		{desc: "slug unicode", validator: validator, input: "café", code: goval.StringSlug},
+	})
+}
+
+func TestStringValidator_SnakeCase(t *testing.T) {
+	validator := goval.String().SnakeCase()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "snake", validator: validator, input: "created_at"},
+		{desc: "snake with digits", validator: validator, input: "utf8_name_2"},
+		{desc: "snake leading digit", validator: validator, input: "2fa_code", code: goval.StringSnakeCase},
+		{desc: "snake leading underscore", validator: validator, input: "_private", code: goval.StringSnakeCase},
+		{desc: "snake double underscore", validator: validator, input: "a__b", code: goval.StringSnakeCase},
+		{desc: "snake uppercase", validator: validator, input: "Created_at", code: goval.StringSnakeCase},
+	})
+}
+
+func TestStringValidator_ScreamingSnake(t *testing.T) {
+	validator := goval.String().ScreamingSnake()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "screaming", validator: validator, input: "DATABASE_URL"},
+		{desc: "screaming single", validator: validator, input: "PORT"},
+		{desc: "screaming lowercase", validator: validator, input: "Database_URL", code: goval.StringScreamingSnake},
+		{desc: "screaming trailing underscore", validator: validator, input: "PORT_", code: goval.StringScreamingSnake},
+	})
+}
+
+func TestStringValidator_CamelCase(t *testing.T) {
+	validator := goval.String().CamelCase()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "camel", validator: validator, input: "createdAt"},
+		{desc: "camel acronym", validator: validator, input: "userID2"},
+		{desc: "camel pascal", validator: validator, input: "CreatedAt", code: goval.StringCamelCase},
+		{desc: "camel underscore", validator: validator, input: "created_at", code: goval.StringCamelCase},
+		{desc: "camel empty", validator: validator, input: "", code: goval.StringCamelCase},
+	})
+}
+
+func TestStringValidator_KebabCase(t *testing.T) {
+	validator := goval.String().KebabCase()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "kebab", validator: validator, input: "max-age"},
+		{desc: "kebab leading digit", validator: validator, input: "2-col", code: goval.StringKebabCase},
+		{desc: "kebab underscore", validator: validator, input: "max_age", code: goval.StringKebabCase},
+	})
+}
+
+func TestStringValidator_Trimmed(t *testing.T) {
+	validator := goval.String().Trimmed()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "trimmed", validator: validator, input: "a b"},
+		{desc: "trimmed empty", validator: validator, input: ""},
+		{desc: "leading space", validator: validator, input: " a", code: goval.StringTrimmed},
+		{desc: "trailing newline", validator: validator, input: "a\n", code: goval.StringTrimmed},
+		{desc: "no-break space", validator: validator, input: "a ", code: goval.StringTrimmed},
+		{desc: "ideographic space", validator: validator, input: "　a", code: goval.StringTrimmed},
+	})
+}
+
+func TestStringValidator_SingleLine(t *testing.T) {
+	validator := goval.String().SingleLine()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "single line", validator: validator, input: "a\tb"},
+		{desc: "line feed", validator: validator, input: "ab\ncd", code: goval.StringSingleLine, args: []any{2}},
+		{desc: "carriage return", validator: validator, input: "a\rb", code: goval.StringSingleLine, args: []any{1}},
+		{desc: "line separator", validator: validator, input: "é ", code: goval.StringSingleLine, args: []any{2}},
+	})
+}
+
+func TestStringValidator_NoControlChars(t *testing.T) {
+	validator := goval.String().NoControlChars()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "no control", validator: validator, input: "hello, 世界"},
+		{desc: "tab", validator: validator, input: "a\tb", code: goval.StringNoControlChars, args: []any{1}},
+		{desc: "nul", validator: validator, input: "ab\x00", code: goval.StringNoControlChars, args: []any{2}},
+		{desc: "c1 control", validator: validator, input: "\u009b", code: goval.StringNoControlChars, args: []any{0}},
+	})
+}
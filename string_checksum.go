@@ -0,0 +1,324 @@
+package goval
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg-id/goval/funcs"
+	"github.com/pkg-id/goval/internal/digits"
+)
+
+// CardBrand is the brand of a payment card, detected from the issuer identification number.
+type CardBrand string
+
+// The payment card brands detected by DetectCardBrand.
+const (
+	CardVisa       CardBrand = "visa"
+	CardMastercard CardBrand = "mastercard"
+	CardAmex       CardBrand = "amex"
+	CardDiscover   CardBrand = "discover"
+	CardJCB        CardBrand = "jcb"
+	CardDinersClub CardBrand = "diners_club"
+	CardUnionPay   CardBrand = "unionpay"
+	CardMaestro    CardBrand = "maestro"
+)
+
+// cardBrandRule is the issuer identification number ranges and the allowed lengths of a brand.
+type cardBrandRule struct {
+	brand   CardBrand
+	ranges  [][2]int // inclusive ranges of the prefixes, the prefixes of a range have the same number of digits.
+	lengths []int
+}
+
+// cardBrandRules are ordered from the most specific, the first matching rule wins.
+var cardBrandRules = []cardBrandRule{
+	{brand: CardAmex, ranges: [][2]int{{34, 34}, {37, 37}}, lengths: []int{15}},
+	{brand: CardDinersClub, ranges: [][2]int{{300, 305}, {36, 36}, {38, 39}}, lengths: []int{14, 15, 16, 17, 18, 19}},
+	{brand: CardJCB, ranges: [][2]int{{3528, 3589}}, lengths: []int{16, 17, 18, 19}},
+	{brand: CardMaestro, ranges: [][2]int{{5018, 5018}, {5020, 5020}, {5038, 5038}, {5893, 5893}, {6304, 6304}, {6759, 6759}, {6761, 6763}}, lengths: []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{brand: CardMastercard, ranges: [][2]int{{51, 55}, {2221, 2720}}, lengths: []int{16}},
+	{brand: CardDiscover, ranges: [][2]int{{6011, 6011}, {644, 649}, {65, 65}, {622126, 622925}}, lengths: []int{16, 17, 18, 19}},
+	{brand: CardUnionPay, ranges: [][2]int{{62, 62}}, lengths: []int{16, 17, 18, 19}},
+	{brand: CardVisa, ranges: [][2]int{{4, 4}}, lengths: []int{13, 16, 19}},
+}
+
+// CreditCardOptions is the policy of the CreditCard rule.
+type CreditCardOptions struct {
+	// Brands are the allowed brands, empty allows any detected brand.
+	Brands []CardBrand
+}
+
+// CreditCard ensures that the string is a payment card number of a known brand with a valid Luhn check digit.
+// The digits may be grouped by spaces or hyphens, e.g. "4111 1111 1111 1111".
+//
+// It returns StringCreditCard if the number is not of a known brand or has the wrong length,
+// StringCreditCardChecksum with the brand if the check digit is wrong,
+// and StringCreditCardBrand with the brand and the allowed brands if the brand is not allowed.
+func (f SVV[T]) CreditCard(opts CreditCardOptions) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		number := digits.Strip(string(value), " -")
+		brand, ok := DetectCardBrand(number)
+		if !ok {
+			return NewRuleError(StringCreditCard)
+		}
+
+		if !digits.Luhn(number) {
+			return NewRuleError(StringCreditCardChecksum, brand)
+		}
+
+		if len(opts.Brands) > 0 && !funcs.Contains(opts.Brands, func(b CardBrand) bool { return b == brand }) {
+			return NewRuleError(StringCreditCardBrand, brand, opts.Brands)
+		}
+		return nil
+	})
+}
+
+// DetectCardBrand returns the brand of the card number by its prefix and its length.
+// The number must only contain digits, the check digit is not verified.
+func DetectCardBrand(number string) (CardBrand, bool) {
+	if !digits.Valid(number) {
+		return "", false
+	}
+
+	for _, rule := range cardBrandRules {
+		if !funcs.Contains(rule.lengths, func(n int) bool { return n == len(number) }) {
+			continue
+		}
+
+		for _, r := range rule.ranges {
+			n := digitCount(r[0])
+			if len(number) < n {
+				continue
+			}
+
+			prefix := digits.Atoi(number[:n])
+			if prefix >= r[0] && prefix <= r[1] {
+				return rule.brand, true
+			}
+		}
+	}
+	return "", false
+}
+
+// ibanLengths are the lengths of the IBAN by the country code, as listed by the IBAN registry.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27,
+	"BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28,
+	"EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23,
+	"GL": 18, "GR": 27, "GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "LY": 25,
+	"MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31, "MU": 30, "NI": 28, "NL": 18,
+	"NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33,
+	"SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// IBAN ensures that the string is an International Bank Account Number with the length of its country
+// and a valid mod-97 checksum, see ISO 13616. The print format with spaces, e.g. "GB82 WEST 1234 5698 7654 32", is accepted.
+//
+// It returns StringIBAN if the string is not shaped as an IBAN, StringIBANCountry with the country
+// if the country does not use IBAN, StringIBANLength with the country and its length if the length is wrong,
+// and StringIBANChecksum with the country if the checksum is wrong.
+func (f SVV[T]) IBAN() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		iban := strings.ToUpper(digits.Strip(string(value), " "))
+		if len(iban) < 5 || !isUpperAlpha(iban[:2]) || !digits.Valid(iban[2:4]) || !isUpperAlnum(iban[4:]) {
+			return NewRuleError(StringIBAN)
+		}
+
+		country := iban[:2]
+		length, ok := ibanLengths[country]
+		if !ok {
+			return NewRuleError(StringIBANCountry, country)
+		}
+
+		if len(iban) != length {
+			return NewRuleError(StringIBANLength, country, length)
+		}
+
+		if mod97(iban[4:]+iban[:4]) != 1 {
+			return NewRuleError(StringIBANChecksum, country)
+		}
+		return nil
+	})
+}
+
+// ISBN ensures that the string is either an ISBN-10 or an ISBN-13 with a valid check digit.
+// The groups may be separated by hyphens or spaces, e.g. "978-0-306-40615-7".
+func (f SVV[T]) ISBN() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		isbn := digits.Strip(string(value), " -")
+		if !isISBN10(isbn) && !isISBN13(isbn) {
+			return NewRuleError(StringISBN)
+		}
+		return nil
+	})
+}
+
+// ISBN10 ensures that the string is an ISBN-10 with a valid check digit, the last digit may be "X".
+// The groups may be separated by hyphens or spaces, e.g. "0-306-40615-2".
+func (f SVV[T]) ISBN10() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if !isISBN10(digits.Strip(string(value), " -")) {
+			return NewRuleError(StringISBN10)
+		}
+		return nil
+	})
+}
+
+// ISBN13 ensures that the string is an ISBN-13 with the prefix 978 or 979 and a valid check digit.
+// The groups may be separated by hyphens or spaces, e.g. "978-0-306-40615-7".
+func (f SVV[T]) ISBN13() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if !isISBN13(digits.Strip(string(value), " -")) {
+			return NewRuleError(StringISBN13)
+		}
+		return nil
+	})
+}
+
+// EAN8 ensures that the string is an 8-digit European Article Number with a valid check digit.
+func (f SVV[T]) EAN8() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if !isGTIN(string(value), 8) {
+			return NewRuleError(StringEAN8)
+		}
+		return nil
+	})
+}
+
+// EAN13 ensures that the string is a 13-digit European Article Number with a valid check digit.
+func (f SVV[T]) EAN13() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if !isGTIN(string(value), 13) {
+			return NewRuleError(StringEAN13)
+		}
+		return nil
+	})
+}
+
+// UPCA ensures that the string is a 12-digit Universal Product Code with a valid check digit.
+func (f SVV[T]) UPCA() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if !isGTIN(string(value), 12) {
+			return NewRuleError(StringUPCA)
+		}
+		return nil
+	})
+}
+
+// ISIN ensures that the string is an International Securities Identification Number, see ISO 6166:
+// a 2-letter country code, a 9-character alphanumeric security identifier and a Luhn check digit.
+func (f SVV[T]) ISIN() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		isin := string(value)
+		if len(isin) != 12 || !isUpperAlpha(isin[:2]) || !isUpperAlnum(isin[2:11]) || !digits.Valid(isin[11:]) {
+			return NewRuleError(StringISIN)
+		}
+
+		var sb strings.Builder
+		for i := 0; i < len(isin); i++ {
+			sb.WriteString(alnumValue(isin[i]))
+		}
+
+		if !digits.Luhn(sb.String()) {
+			return NewRuleError(StringISIN)
+		}
+		return nil
+	})
+}
+
+// isISBN10 reports whether the string is 9 digits followed by a check digit or "X", with a valid mod-11 checksum.
+func isISBN10(s string) bool {
+	if len(s) != 10 || !digits.Valid(s[:9]) {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(s[i]-'0') * (10 - i)
+	}
+
+	switch c := s[9]; {
+	case c == 'X' || c == 'x':
+		sum += 10
+	case c >= '0' && c <= '9':
+		sum += int(c - '0')
+	default:
+		return false
+	}
+	return sum%11 == 0
+}
+
+// isISBN13 reports whether the string is an EAN-13 of the Bookland prefixes 978 and 979.
+func isISBN13(s string) bool {
+	return isGTIN(s, 13) && (strings.HasPrefix(s, "978") || strings.HasPrefix(s, "979"))
+}
+
+// isGTIN reports whether the string has the given number of digits and a valid GTIN check digit,
+// the digits are weighted 3 and 1 alternately from the right, excluding the check digit.
+func isGTIN(s string, length int) bool {
+	if len(s) != length || !digits.Valid(s) {
+		return false
+	}
+
+	sum := 0
+	for i := len(s) - 2; i >= 0; i-- {
+		d := int(s[i] - '0')
+		if (len(s)-2-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return (10-sum%10)%10 == int(s[len(s)-1]-'0')
+}
+
+// mod97 returns the remainder of the number formed by replacing each letter of s with its value, A is 10 and Z is 35.
+func mod97(s string) int {
+	rem := 0
+	for i := 0; i < len(s); i++ {
+		for _, d := range alnumValue(s[i]) {
+			rem = (rem*10 + int(d-'0')) % 97
+		}
+	}
+	return rem
+}
+
+// alnumValue returns the decimal value of an uppercase alphanumeric, A is "10" and Z is "35".
+func alnumValue(c byte) string {
+	if c >= 'A' && c <= 'Z' {
+		v := int(c-'A') + 10
+		return string([]byte{byte('0' + v/10), byte('0' + v%10)})
+	}
+	return string(c)
+}
+
+// isUpperAlpha reports whether s only contains ASCII uppercase letters.
+func isUpperAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// isUpperAlnum reports whether s only contains ASCII uppercase letters and digits.
+func isUpperAlnum(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if (s[i] < 'A' || s[i] > 'Z') && (s[i] < '0' || s[i] > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// digitCount returns the number of decimal digits of a non-negative n.
+func digitCount(n int) int {
+	count := 1
+	for n >= 10 {
+		n /= 10
+		count++
+	}
+	return count
+}
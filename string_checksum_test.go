@@ -0,0 +1,125 @@
+package goval_test
+
+import (
+	"testing"
+
+	"github.com/pkg-id/goval"
+)
+
+func TestDetectCardBrand(t *testing.T) {
+	tests := []struct {
+		number string
+		brand  goval.CardBrand
+	}{
+		{number: "4111111111111111", brand: goval.CardVisa},
+		{number: "5555555555554444", brand: goval.CardMastercard},
+		{number: "2223003122003222", brand: goval.CardMastercard},
+		{number: "378282246310005", brand: goval.CardAmex},
+		{number: "6011111111111117", brand: goval.CardDiscover},
+		{number: "6221260000000000", brand: goval.CardDiscover},
+		{number: "3530111333300000", brand: goval.CardJCB},
+		{number: "30569309025904", brand: goval.CardDinersClub},
+		{number: "6200000000000005", brand: goval.CardUnionPay},
+		{number: "6759649826438453", brand: goval.CardMaestro},
+		{number: "9111111111111111"},
+		{number: "411111111111"},
+		{number: "4111-1111-1111"},
+	}
+
+	for _, tc := range tests {
+		brand, ok := goval.DetectCardBrand(tc.number)
+		if ok != (tc.brand != "") || brand != tc.brand {
+			t.Errorf("%s: expect brand %q; got %q, %v", tc.number, tc.brand, brand, ok)
+		}
+	}
+}
+
+func TestStringValidator_CreditCard(t *testing.T) {
+	card := goval.String().CreditCard(goval.CreditCardOptions{})
+	visaOnly := goval.String().CreditCard(goval.CreditCardOptions{Brands: []goval.CardBrand{goval.CardVisa}})
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "card ok", validator: card, input: "4111 1111 1111 1111"},
+		{desc: "card unknown brand", validator: card, input: "9111111111111111", code: goval.StringCreditCard},
+		{desc: "card not digits", validator: card, input: "4111x11111111111", code: goval.StringCreditCard},
+		{desc: "card checksum", validator: card, input: "4111-1111-1111-1112", code: goval.StringCreditCardChecksum, args: []any{goval.CardVisa}},
+		{desc: "card brand allowed", validator: visaOnly, input: "4111111111111111"},
+		{
+			desc:      "card brand not allowed",
+			validator: visaOnly,
+			input:     "378282246310005",
+			code:      goval.StringCreditCardBrand,
+			args:      []any{goval.CardAmex, []goval.CardBrand{goval.CardVisa}},
+		},
+	})
+}
+
+func TestStringValidator_IBAN(t *testing.T) {
+	validator := goval.String().IBAN()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "iban ok", validator: validator, input: "GB82 WEST 1234 5698 7654 32"},
+		{desc: "iban lowercase", validator: validator, input: "de89370400440532013000"},
+		{desc: "iban shape", validator: validator, input: "GB-82", code: goval.StringIBAN},
+		{desc: "iban country", validator: validator, input: "US12345678901234", code: goval.StringIBANCountry, args: []any{"US"}},
+		{desc: "iban length", validator: validator, input: "NL91ABNA041716430", code: goval.StringIBANLength, args: []any{"NL", 18}},
+		{desc: "iban checksum", validator: validator, input: "NL92ABNA0417164300", code: goval.StringIBANChecksum, args: []any{"NL"}},
+	})
+}
+
+func TestStringValidator_ISBN10(t *testing.T) {
+	validator := goval.String().ISBN10()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "isbn10 ok", validator: validator, input: "0-306-40615-2"},
+		{desc: "isbn10 x", validator: validator, input: "080442957X"},
+		{desc: "isbn10 checksum", validator: validator, input: "0306406153", code: goval.StringISBN10},
+	})
+}
+
+func TestStringValidator_ISBN13(t *testing.T) {
+	validator := goval.String().ISBN13()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "isbn13 ok", validator: validator, input: "978-0-306-40615-7"},
+		{desc: "isbn13 prefix", validator: validator, input: "4006381333931", code: goval.StringISBN13},
+	})
+}
+
+func TestStringValidator_ISBN(t *testing.T) {
+	validator := goval.String().ISBN()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "isbn either", validator: validator, input: "0306406152"},
+		{desc: "isbn checksum", validator: validator, input: "9780306406158", code: goval.StringISBN},
+	})
+}
+
+func TestStringValidator_EAN8(t *testing.T) {
+	validator := goval.String().EAN8()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "ean8 ok", validator: validator, input: "96385074"},
+		{desc: "ean8 checksum", validator: validator, input: "96385075", code: goval.StringEAN8},
+	})
+}
+
+func TestStringValidator_EAN13(t *testing.T) {
+	validator := goval.String().EAN13()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "ean13 ok", validator: validator, input: "4006381333931"},
+		{desc: "ean13 length", validator: validator, input: "400638133393", code: goval.StringEAN13},
+	})
+}
+
+func TestStringValidator_UPCA(t *testing.T) {
+	validator := goval.String().UPCA()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "upca ok", validator: validator, input: "036000291452"},
+		{desc: "upca checksum", validator: validator, input: "036000291453", code: goval.StringUPCA},
+	})
+}
+
+func TestStringValidator_ISIN(t *testing.T) {
+	validator := goval.String().ISIN()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "isin ok", validator: validator, input: "US0378331005"},
+		{desc: "isin letters", validator: validator, input: "AU0000XVGZA3"},
+		{desc: "isin checksum", validator: validator, input: "US0378331006", code: goval.StringISIN},
+		{desc: "isin shape", validator: validator, input: "us0378331005", code: goval.StringISIN},
+	})
+}
@@ -0,0 +1,113 @@
+package goval
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+)
+
+// Base64Encoding selects the alphabet and the padding of the Base64 rule.
+type Base64Encoding int
+
+const (
+	// Base64Std is the standard encoding with padding, see RFC 4648 section 4.
+	Base64Std Base64Encoding = iota
+	// Base64URL is the URL and file name safe encoding with padding, see RFC 4648 section 5.
+	Base64URL
+	// Base64RawStd is the standard encoding without padding.
+	Base64RawStd
+	// Base64RawURL is the URL and file name safe encoding without padding.
+	Base64RawURL
+)
+
+// encoding returns the encoding of the standard library, the unknown values fall back to base64.StdEncoding.
+func (e Base64Encoding) encoding() *base64.Encoding {
+	switch e {
+	case Base64URL:
+		return base64.URLEncoding
+	case Base64RawStd:
+		return base64.RawStdEncoding
+	case Base64RawURL:
+		return base64.RawURLEncoding
+	default:
+		return base64.StdEncoding
+	}
+}
+
+// JSON ensures that the string is a JSON document, then validates the decoded value with the given validator.
+// The value is decoded by json.Unmarshal into an any, so objects are map[string]any, arrays are []any
+// and numbers are float64. The validator may be nil to only check the syntax.
+//
+// On malformed input it returns StringJSON with the byte offset of the error.
+// The errors of the validator are returned as is, so they nest under the key of the field when used by Named.
+func (f SVV[T]) JSON(validator RuleValidator[any]) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		var doc any
+		if err := json.Unmarshal([]byte(value), &doc); err != nil {
+			var syntaxErr *json.SyntaxError
+			offset := len(value)
+			if errors.As(err, &syntaxErr) {
+				offset = int(syntaxErr.Offset)
+			}
+			return NewRuleError(StringJSON, offset)
+		}
+		return validateDecoded(ctx, validator, doc)
+	})
+}
+
+// Base64 ensures that the string is decodable by the given encoding, then validates the decoded bytes
+// with the given validator. The validator may be nil to only check the encoding.
+//
+// On malformed input it returns StringBase64 with the byte offset of the error.
+// The errors of the validator are returned as is, so they nest under the key of the field when used by Named.
+func (f SVV[T]) Base64(encoding Base64Encoding, validator RuleValidator[[]byte]) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		b, err := encoding.encoding().DecodeString(string(value))
+		if err != nil {
+			offset := len(value)
+			var corruptErr base64.CorruptInputError
+			if errors.As(err, &corruptErr) {
+				offset = int(corruptErr)
+			}
+			return NewRuleError(StringBase64, offset)
+		}
+		return validateDecoded(ctx, validator, b)
+	})
+}
+
+// Hex ensures that the string is an even number of hexadecimal digits, in either case, then validates
+// the decoded bytes with the given validator. The validator may be nil to only check the encoding.
+//
+// On malformed input it returns StringHex with the byte offset of the error.
+// The errors of the validator are returned as is, so they nest under the key of the field when used by Named.
+func (f SVV[T]) Hex(validator RuleValidator[[]byte]) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		b, err := hex.DecodeString(string(value))
+		if err != nil {
+			offset := len(value)
+			for i := 0; i < len(value); i++ {
+				if !isHexDigit(value[i]) {
+					offset = i
+					break
+				}
+			}
+			return NewRuleError(StringHex, offset)
+		}
+		return validateDecoded(ctx, validator, b)
+	})
+}
+
+// validateDecoded validates the decoded value with the validator, if any.
+func validateDecoded[V any](ctx context.Context, validator RuleValidator[V], value V) error {
+	if validator == nil {
+		return nil
+	}
+	return validator.Validate(ctx, value)
+}
+
+// isHexDigit reports whether the byte is a hexadecimal digit.
+func isHexDigit(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
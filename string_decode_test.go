@@ -0,0 +1,62 @@
+package goval_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg-id/goval"
+)
+
+func TestStringValidator_JSON(t *testing.T) {
+	validator := goval.String().JSON(nil)
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "json ok", validator: validator, input: `{"a":[1,2]}`},
+		{desc: "json malformed", validator: validator, input: `{"a":]`, code: goval.StringJSON, args: []any{6}},
+		{desc: "json truncated", validator: validator, input: `{"a":`, code: goval.StringJSON, args: []any{5}},
+		{desc: "json empty", validator: validator, input: "", code: goval.StringJSON, args: []any{0}},
+	})
+}
+
+func TestStringValidator_Base64(t *testing.T) {
+	maxBytes := goval.Slice[byte, []byte]().Max(3)
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "base64 std ok", validator: goval.String().Base64(goval.Base64Std, nil), input: "aGk/Pz8="},
+		{
+			desc:      "base64 std rejects url alphabet",
+			validator: goval.String().Base64(goval.Base64Std, nil),
+			input:     "aGk_Pz8=",
+			code:      goval.StringBase64,
+			args:      []any{3},
+		},
+		{desc: "base64 url ok", validator: goval.String().Base64(goval.Base64URL, nil), input: "aGk_Pz8="},
+		{desc: "base64 raw rejects padding", validator: goval.String().Base64(goval.Base64RawStd, nil), input: "aGk/Pz8=", code: goval.StringBase64, args: []any{7}},
+		{desc: "base64 raw url ok", validator: goval.String().Base64(goval.Base64RawURL, nil), input: "aGk_Pz8"},
+		{desc: "base64 nested", validator: goval.String().Base64(goval.Base64Std, maxBytes), input: "aGk/Pz8=", code: goval.SliceMax, args: []any{3}},
+	})
+}
+
+func TestStringValidator_Hex(t *testing.T) {
+	maxBytes := goval.Slice[byte, []byte]().Max(3)
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "hex ok", validator: goval.String().Hex(maxBytes), input: "C0ffee"},
+		{desc: "hex invalid digit", validator: goval.String().Hex(nil), input: "c0fgee", code: goval.StringHex, args: []any{3}},
+		{desc: "hex odd length", validator: goval.String().Hex(nil), input: "c0ffe", code: goval.StringHex, args: []any{5}},
+		{desc: "hex nested", validator: goval.String().Hex(maxBytes), input: "c0ffee00", code: goval.SliceMax, args: []any{3}},
+	})
+}
+
+func TestStringValidator_JSON_Nested(t *testing.T) {
+	title := goval.Use(func(ctx context.Context, doc any) error {
+		obj, _ := doc.(map[string]any)
+		s, _ := obj["title"].(string)
+		return goval.Named("title", s, goval.String().Required()).Validate(ctx)
+	})
+
+	err := goval.Named("metadata", `{"title":""}`, goval.String().JSON(title)).Validate(context.Background())
+	b, _ := json.Marshal(err)
+	expected := `{"key":"metadata","err":{"key":"title","err":{"code":2000}}}`
+	if string(b) != expected {
+		t.Errorf("expect %s; got %s", expected, b)
+	}
+}
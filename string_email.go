@@ -0,0 +1,184 @@
+package goval
+
+import (
+	"bufio"
+	"context"
+	_ "embed"
+	"net/mail"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Email address limits of RFC 5321 section 4.5.3.1.
+const (
+	emailLocalMaxLength  = 64
+	emailDomainMaxLength = 253
+)
+
+//go:embed data/disposable_domains.txt
+var disposableDomainsFile string
+
+var disposableDomains map[string]struct{}
+var disposableDomainsOnce sync.Once
+
+// EmailOptions is the policy of the Email rule, the zero value only checks the syntax and the length limits.
+type EmailOptions struct {
+	// ForbidDisplayName rejects the addresses with a display name or angle brackets, e.g. "Bob <bob@example.com>".
+	ForbidDisplayName bool
+	// RequireTLD requires the domain to have at least two labels and an alphabetic top-level domain.
+	RequireTLD bool
+	// AllowedDomains are the allowed domains. A "*." prefix matches any subdomain, but not the domain itself.
+	// Empty allows any domain.
+	AllowedDomains []string
+	// DeniedDomains are the denied domains, matched in the same way as AllowedDomains.
+	DeniedDomains []string
+	// ForbidDisposable rejects the domains, and their subdomains, of the embedded list of disposable email providers.
+	ForbidDisposable bool
+}
+
+// Email ensures the string is an email address as parsed by net/mail, within the limits of RFC 5321:
+// the local part is at most 64 bytes and the domain is at most 253 bytes.
+// The domain must be a host name, the domain literals such as "[192.0.2.1]" are rejected.
+func (f SVV[T]) Email(opts EmailOptions) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		return validateEmail(string(value), opts)
+	})
+}
+
+// validateEmail checks the email against the policy and returns the first violation.
+func validateEmail(value string, opts EmailOptions) error {
+	addr, err := mail.ParseAddress(value)
+	if err != nil {
+		return NewRuleError(StringEmail)
+	}
+
+	if opts.ForbidDisplayName && (addr.Name != "" || strings.ContainsAny(value, "<>")) {
+		return NewRuleError(StringEmailDisplayName)
+	}
+
+	at := strings.LastIndexByte(addr.Address, '@')
+	local, domain := addr.Address[:at], strings.ToLower(addr.Address[at+1:])
+
+	if len(local) > emailLocalMaxLength {
+		return NewRuleError(StringEmailLocalLength, emailLocalMaxLength)
+	}
+
+	if len(domain) > emailDomainMaxLength {
+		return NewRuleError(StringEmailDomainLength, emailDomainMaxLength)
+	}
+
+	if !isEmailDomain(domain) {
+		return NewRuleError(StringEmail)
+	}
+
+	if opts.RequireTLD && !hasTLD(domain) {
+		return NewRuleError(StringEmailTLD)
+	}
+
+	if matchHost(opts.DeniedDomains, domain) {
+		return NewRuleError(StringEmailDomainDenied, domain)
+	}
+
+	if len(opts.AllowedDomains) > 0 && !matchHost(opts.AllowedDomains, domain) {
+		return NewRuleError(StringEmailDomainNotAllowed, domain)
+	}
+
+	if opts.ForbidDisposable && isDisposableDomain(domain) {
+		return NewRuleError(StringEmailDisposable, domain)
+	}
+	return nil
+}
+
+// NormalizeEmailOptions configures NormalizeEmail.
+type NormalizeEmailOptions struct {
+	// StripPlusTag removes the sub-address from the local part, e.g. "bob+news@example.com" becomes "bob@example.com".
+	StripPlusTag bool
+}
+
+// NormalizeEmail returns the bare address of the email with the domain in lowercase.
+// The local part is kept as is, since it is case-sensitive by RFC 5321.
+// It returns a RuleError with StringEmail if the email cannot be parsed.
+func NormalizeEmail(email string, opts NormalizeEmailOptions) (string, error) {
+	addr, err := mail.ParseAddress(email)
+	if err != nil {
+		return "", NewRuleError(StringEmail)
+	}
+
+	at := strings.LastIndexByte(addr.Address, '@')
+	local, domain := addr.Address[:at], strings.ToLower(addr.Address[at+1:])
+	if opts.StripPlusTag {
+		if i := strings.IndexByte(local, '+'); i > 0 {
+			local = local[:i]
+		}
+	}
+	return local + "@" + domain, nil
+}
+
+// isEmailDomain reports whether the domain is a host name, the labels may contain Unicode letters and digits
+// for the internationalized domain names.
+func isEmailDomain(domain string) bool {
+	if domain == "" || strings.HasSuffix(domain, ".") {
+		return false
+	}
+
+	for _, label := range strings.Split(domain, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+
+		for _, r := range label {
+			if r != '-' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// hasTLD reports whether the domain has at least two labels and the last one is alphabetic,
+// either in Unicode or in the punycode form "xn--".
+func hasTLD(domain string) bool {
+	i := strings.LastIndexByte(domain, '.')
+	if i < 0 {
+		return false
+	}
+
+	tld := domain[i+1:]
+	if strings.HasPrefix(tld, "xn--") {
+		return len(tld) > 4
+	}
+
+	for _, r := range tld {
+		if !unicode.IsLetter(r) {
+			return false
+		}
+	}
+	return len([]rune(tld)) >= 2
+}
+
+// isDisposableDomain reports whether the domain or one of its parent domains is a disposable email provider.
+func isDisposableDomain(domain string) bool {
+	disposableDomainsOnce.Do(func() {
+		disposableDomains = make(map[string]struct{})
+		scanner := bufio.NewScanner(strings.NewReader(disposableDomainsFile))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line != "" && !strings.HasPrefix(line, "#") {
+				disposableDomains[line] = struct{}{}
+			}
+		}
+	})
+
+	for {
+		if _, ok := disposableDomains[domain]; ok {
+			return true
+		}
+
+		i := strings.IndexByte(domain, '.')
+		if i < 0 {
+			return false
+		}
+		domain = domain[i+1:]
+	}
+}
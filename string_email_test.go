@@ -0,0 +1,79 @@
+package goval_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/pkg-id/goval"
+)
+
+func TestStringValidator_Email(t *testing.T) {
+	plain := goval.String().Email(goval.EmailOptions{})
+	signup := goval.String().Email(goval.EmailOptions{
+		ForbidDisplayName: true,
+		RequireTLD:        true,
+		DeniedDomains:     []string{"*.example.net"},
+		ForbidDisposable:  true,
+	})
+	corporate := goval.String().Email(goval.EmailOptions{AllowedDomains: []string{"example.com", "*.example.org"}})
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "plain", validator: plain, input: "bob@example.com"},
+		{desc: "display name", validator: plain, input: "Bob <bob@example.com>"},
+		{desc: "quoted local part", validator: plain, input: `"bob smith"@example.com`},
+		{desc: "unicode domain", validator: plain, input: "bob@bücher.de"},
+		{desc: "single label domain", validator: plain, input: "root@localhost"},
+		{desc: "empty", validator: plain, input: "", code: goval.StringEmail},
+		{desc: "missing at", validator: plain, input: "bob.example.com", code: goval.StringEmail},
+		{desc: "missing domain", validator: plain, input: "bob@", code: goval.StringEmail},
+		{desc: "bad label", validator: plain, input: "bob@-example.com", code: goval.StringEmail},
+		{desc: "domain literal", validator: plain, input: "bob@[192.0.2.1]", code: goval.StringEmail},
+		{desc: "local length", validator: plain, input: strings.Repeat("a", 65) + "@example.com", code: goval.StringEmailLocalLength, args: []any{64}},
+		{desc: "domain length", validator: plain, input: "bob@" + strings.Repeat("a.", 127) + "com", code: goval.StringEmailDomainLength, args: []any{253}},
+		{desc: "signup ok", validator: signup, input: "bob@Example.COM"},
+		{desc: "forbid display name", validator: signup, input: "Bob <bob@example.com>", code: goval.StringEmailDisplayName},
+		{desc: "forbid angle brackets", validator: signup, input: "<bob@example.com>", code: goval.StringEmailDisplayName},
+		{desc: "require tld", validator: signup, input: "root@localhost", code: goval.StringEmailTLD},
+		{desc: "numeric tld", validator: signup, input: "bob@example.123", code: goval.StringEmailTLD},
+		{desc: "denied domain", validator: signup, input: "bob@mail.example.net", code: goval.StringEmailDomainDenied, args: []any{"mail.example.net"}},
+		{desc: "disposable", validator: signup, input: "bob@Mailinator.com", code: goval.StringEmailDisposable, args: []any{"mailinator.com"}},
+		{desc: "disposable subdomain", validator: signup, input: "bob@x.yopmail.com", code: goval.StringEmailDisposable, args: []any{"x.yopmail.com"}},
+		{desc: "allowed domain", validator: corporate, input: "bob@example.com"},
+		{desc: "allowed subdomain", validator: corporate, input: "bob@hr.example.org"},
+		{desc: "not allowed", validator: corporate, input: "bob@example.org", code: goval.StringEmailDomainNotAllowed, args: []any{"example.org"}},
+	})
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	tests := []struct {
+		desc  string
+		input string
+		opts  goval.NormalizeEmailOptions
+		want  string
+	}{
+		{desc: "lowercase domain", input: "Bob@Example.COM", want: "Bob@example.com"},
+		{desc: "display name", input: "Bob <bob+news@example.com>", want: "bob+news@example.com"},
+		{desc: "strip plus tag", input: "bob+news@example.com", opts: goval.NormalizeEmailOptions{StripPlusTag: true}, want: "bob@example.com"},
+		{desc: "leading plus", input: "+bob@example.com", opts: goval.NormalizeEmailOptions{StripPlusTag: true}, want: "+bob@example.com"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := goval.NormalizeEmail(tc.input, tc.opts)
+			if err != nil {
+				t.Fatalf("expect no error; got %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("expect %q; got %q", tc.want, got)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := goval.NormalizeEmail("not an email", goval.NormalizeEmailOptions{})
+		var ruleErr *goval.RuleError
+		if !errors.As(err, &ruleErr) || !ruleErr.Code.Equal(goval.StringEmail) {
+			t.Errorf("expect StringEmail; got %v", err)
+		}
+	})
+}
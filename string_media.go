@@ -0,0 +1,129 @@
+package goval
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"strings"
+)
+
+// MediaType ensures that the string is a media type, as in the Content-Type header, that matches one of
+// the allowed patterns, no patterns allow any type. The string is parsed by mime.ParseMediaType,
+// so the type and the parameter names are case-insensitive.
+//
+// A pattern may have a wildcard subtype, e.g. "image/*", a wildcard structured syntax suffix,
+// e.g. "application/*+json", or be "*/*". The parameters of a pattern are constraints,
+// e.g. "text/plain; charset=utf-8" only allows the plain text in UTF-8, the values are compared
+// case-insensitively and the other parameters are allowed.
+//
+// It returns StringMediaType if the string is malformed or has a wildcard, and StringMediaTypeNotAllowed
+// with the type, the parameters and the allowed patterns if no pattern matches. The type is in lowercase,
+// e.g. "text/plain", and the parameters are a map[string]string with lowercase names.
+//
+// It panics if an allowed pattern is malformed.
+func (f SVV[T]) MediaType(allowed ...string) SVV[T] {
+	patterns := make([]mediaTypePattern, len(allowed))
+	for i, pattern := range allowed {
+		p, ok := parseMediaTypePattern(pattern)
+		if !ok {
+			panic(fmt.Sprintf("goval: invalid media type pattern %q", pattern))
+		}
+		patterns[i] = p
+	}
+
+	return f.With(func(ctx context.Context, value T) error {
+		mediaType, typ, subtype, params, ok := parseMediaType(string(value))
+		if !ok {
+			return NewRuleError(StringMediaType)
+		}
+
+		if len(patterns) == 0 {
+			return nil
+		}
+
+		for _, p := range patterns {
+			if p.matches(typ, subtype, params) {
+				return nil
+			}
+		}
+		return NewRuleError(StringMediaTypeNotAllowed, mediaType, params, allowed)
+	})
+}
+
+// MediaTypeNoVendor ensures that the string is a media type that is not in the vendor tree,
+// e.g. "application/vnd.ms-excel". It is meant to be chained with MediaType.
+//
+// It returns StringMediaType if the string is malformed or has a wildcard, and StringMediaTypeVendor
+// with the lowercase type if the type is a vendor type.
+func (f SVV[T]) MediaTypeNoVendor() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		mediaType, _, subtype, _, ok := parseMediaType(string(value))
+		if !ok {
+			return NewRuleError(StringMediaType)
+		}
+
+		if strings.HasPrefix(subtype, "vnd.") {
+			return NewRuleError(StringMediaTypeVendor, mediaType)
+		}
+		return nil
+	})
+}
+
+// parseMediaType parses the media type and splits it into the type and the subtype,
+// a media type with a wildcard is not valid.
+func parseMediaType(value string) (mediaType, typ, subtype string, params map[string]string, ok bool) {
+	mediaType, params, err := mime.ParseMediaType(value)
+	if err != nil {
+		return "", "", "", nil, false
+	}
+
+	typ, subtype, ok = strings.Cut(mediaType, "/")
+	if !ok || typ == "" || subtype == "" || strings.Contains(mediaType, "*") {
+		return "", "", "", nil, false
+	}
+	return mediaType, typ, subtype, params, true
+}
+
+// mediaTypePattern is a parsed allowed pattern of the MediaType rule.
+type mediaTypePattern struct {
+	typ, subtype string
+	params       map[string]string
+}
+
+// parseMediaTypePattern parses the pattern, the wildcard type is only allowed with the wildcard subtype.
+func parseMediaTypePattern(pattern string) (mediaTypePattern, bool) {
+	mediaType, params, err := mime.ParseMediaType(pattern)
+	if err != nil {
+		return mediaTypePattern{}, false
+	}
+
+	typ, subtype, ok := strings.Cut(mediaType, "/")
+	if !ok || typ == "" || subtype == "" || typ == "*" && subtype != "*" {
+		return mediaTypePattern{}, false
+	}
+	return mediaTypePattern{typ: typ, subtype: subtype, params: params}, true
+}
+
+// matches reports whether the parsed media type matches the pattern.
+func (p mediaTypePattern) matches(typ, subtype string, params map[string]string) bool {
+	if p.typ != "*" && p.typ != typ {
+		return false
+	}
+
+	switch {
+	case p.subtype == "*":
+	case strings.HasPrefix(p.subtype, "*+"):
+		if !strings.HasSuffix(subtype, p.subtype[1:]) || len(subtype) == len(p.subtype)-1 {
+			return false
+		}
+	case p.subtype != subtype:
+		return false
+	}
+
+	for name, want := range p.params {
+		if got, ok := params[name]; !ok || !strings.EqualFold(got, want) {
+			return false
+		}
+	}
+	return true
+}
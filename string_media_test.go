@@ -0,0 +1,56 @@
+package goval_test
+
+import (
+	"testing"
+
+	"github.com/pkg-id/goval"
+)
+
+func TestStringValidator_MediaType(t *testing.T) {
+	anyType := goval.String().MediaType()
+	allowed := []string{"image/*", "application/*+json", "text/plain; charset=utf-8"}
+	upload := goval.String().MediaTypeNoVendor().MediaType(allowed...)
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "any", validator: anyType, input: "application/vnd.ms-excel"},
+		{desc: "with parameters", validator: anyType, input: "text/html; charset=UTF-8"},
+		{desc: "malformed", validator: anyType, input: "text/", code: goval.StringMediaType},
+		{desc: "no subtype", validator: anyType, input: "text", code: goval.StringMediaType},
+		{desc: "empty", validator: anyType, input: "", code: goval.StringMediaType},
+		{desc: "bad parameter", validator: anyType, input: "text/plain; charset", code: goval.StringMediaType},
+		{desc: "wildcard value", validator: anyType, input: "image/*", code: goval.StringMediaType},
+		{desc: "wildcard subtype", validator: upload, input: "image/png"},
+		{desc: "case-insensitive", validator: upload, input: "IMAGE/PNG"},
+		{desc: "suffix", validator: upload, input: "application/ld+json"},
+		{desc: "suffix only", validator: upload, input: "application/+json", code: goval.StringMediaTypeNotAllowed, args: []any{"application/+json", map[string]string{}, allowed}},
+		{desc: "parameter", validator: upload, input: "text/plain; charset=UTF-8"},
+		{desc: "extra parameter", validator: upload, input: "text/plain; format=flowed; charset=utf-8"},
+		{
+			desc:      "wrong parameter",
+			validator: upload,
+			input:     "Text/Plain; Charset=ISO-8859-1",
+			code:      goval.StringMediaTypeNotAllowed,
+			args:      []any{"text/plain", map[string]string{"charset": "ISO-8859-1"}, allowed},
+		},
+		{
+			desc:      "missing parameter",
+			validator: upload,
+			input:     "text/plain",
+			code:      goval.StringMediaTypeNotAllowed,
+			args:      []any{"text/plain", map[string]string{}, allowed},
+		},
+		{desc: "other type", validator: upload, input: "application/pdf", code: goval.StringMediaTypeNotAllowed, args: []any{"application/pdf", map[string]string{}, allowed}},
+		{desc: "vendor", validator: upload, input: "application/vnd.api+json", code: goval.StringMediaTypeVendor, args: []any{"application/vnd.api+json"}},
+		{desc: "no vendor", validator: goval.String().MediaTypeNoVendor(), input: "text/plain"},
+		{desc: "no vendor malformed", validator: goval.String().MediaTypeNoVendor(), input: "text/", code: goval.StringMediaType},
+		{desc: "all types", validator: goval.String().MediaType("*/*"), input: "font/woff2"},
+	})
+}
+
+func TestStringValidator_MediaTypeInvalidPattern(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expect a panic")
+		}
+	}()
+	goval.String().MediaType("*/json")
+}
@@ -0,0 +1,168 @@
+package goval
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// nonPublicPrefixes are the special-purpose ranges that are not routable on the public internet,
+// in addition to the ranges that are detected by the methods of netip.Addr.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "this" network.
+	netip.MustParsePrefix("100.64.0.0/10"),   // shared address space (carrier-grade NAT).
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments.
+	netip.MustParsePrefix("192.0.2.0/24"),    // documentation (TEST-NET-1).
+	netip.MustParsePrefix("198.18.0.0/15"),   // benchmarking.
+	netip.MustParsePrefix("198.51.100.0/24"), // documentation (TEST-NET-2).
+	netip.MustParsePrefix("203.0.113.0/24"),  // documentation (TEST-NET-3).
+	netip.MustParsePrefix("240.0.0.0/4"),     // reserved, including the limited broadcast.
+	netip.MustParsePrefix("64:ff9b::/96"),    // IPv4/IPv6 translation, it may embed a private IPv4.
+	netip.MustParsePrefix("64:ff9b:1::/48"),  // local-use IPv4/IPv6 translation.
+	netip.MustParsePrefix("100::/64"),        // discard-only.
+	netip.MustParsePrefix("2001:db8::/32"),   // documentation.
+	netip.MustParsePrefix("2002::/16"),       // 6to4, it may embed a private IPv4.
+}
+
+// isPublicAddr reports whether the address is a globally routable unicast address.
+// The IPv4-mapped IPv6 addresses are checked as IPv4, so "::ffff:127.0.0.1" is not public.
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() || addr.Zone() != "" {
+		return false
+	}
+
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// isHostname reports whether s is a valid DNS host name as described by RFC 1123.
+// A single trailing dot of a fully qualified name is allowed.
+func isHostname(s string) bool {
+	s = strings.TrimSuffix(s, ".")
+	if s == "" || len(s) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(s, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// IP ensures the string is an IPv4 or IPv6 address.
+func (f SVV[T]) IP() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if _, err := netip.ParseAddr(string(value)); err != nil {
+			return NewRuleError(StringIP)
+		}
+		return nil
+	})
+}
+
+// IPv4 ensures the string is an IPv4 address in the dotted decimal form.
+func (f SVV[T]) IPv4() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		addr, err := netip.ParseAddr(string(value))
+		if err != nil || !addr.Is4() {
+			return NewRuleError(StringIPv4)
+		}
+		return nil
+	})
+}
+
+// IPv6 ensures the string is an IPv6 address, including the IPv4-mapped form such as "::ffff:10.0.0.1".
+func (f SVV[T]) IPv6() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		addr, err := netip.ParseAddr(string(value))
+		if err != nil || !addr.Is6() {
+			return NewRuleError(StringIPv6)
+		}
+		return nil
+	})
+}
+
+// CIDR ensures the string is an IP prefix in the CIDR notation, such as "10.0.0.0/8".
+func (f SVV[T]) CIDR() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if _, err := netip.ParsePrefix(string(value)); err != nil {
+			return NewRuleError(StringCIDR)
+		}
+		return nil
+	})
+}
+
+// IPInPrefix ensures the string is an IP address that is contained by one of the given prefixes.
+func (f SVV[T]) IPInPrefix(prefixes ...netip.Prefix) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		addr, err := netip.ParseAddr(string(value))
+		if err == nil {
+			addr = addr.Unmap()
+			for _, prefix := range prefixes {
+				if prefix.Contains(addr) {
+					return nil
+				}
+			}
+		}
+		return NewRuleError(StringIPInPrefix, prefixes)
+	})
+}
+
+// HostPort ensures the string is a host and a port, such as "example.com:443" or "[::1]:80".
+// The host must be an IP address or a host name, and the port must be a number between 0 and 65535.
+func (f SVV[T]) HostPort() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		host, port, err := net.SplitHostPort(string(value))
+		if err != nil {
+			return NewRuleError(StringHostPort)
+		}
+
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			return NewRuleError(StringHostPort)
+		}
+
+		if _, err := netip.ParseAddr(host); err != nil && !isHostname(host) {
+			return NewRuleError(StringHostPort)
+		}
+		return nil
+	})
+}
+
+// MAC ensures the string is a hardware address in one of the forms accepted by net.ParseMAC,
+// such as "00:00:5e:00:53:01" or "0000.5e00.5301".
+func (f SVV[T]) MAC() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if _, err := net.ParseMAC(string(value)); err != nil {
+			return NewRuleError(StringMAC)
+		}
+		return nil
+	})
+}
+
+// PublicIP ensures the string is a globally routable unicast IP address.
+// The private, loopback, link-local, multicast, unspecified, documentation and other special-purpose
+// ranges are rejected, which makes it suitable for guarding against SSRF.
+func (f SVV[T]) PublicIP() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		addr, err := netip.ParseAddr(string(value))
+		if err != nil || !isPublicAddr(addr) {
+			return NewRuleError(StringPublicIP)
+		}
+		return nil
+	})
+}
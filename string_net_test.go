@@ -0,0 +1,101 @@
+package goval_test
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/pkg-id/goval"
+)
+
+func TestStringValidator_IP(t *testing.T) {
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "ipv4", validator: goval.String().IP(), input: "192.168.1.1"},
+		{desc: "ipv6", validator: goval.String().IP(), input: "2001:db8::1"},
+		{desc: "invalid", validator: goval.String().IP(), input: "256.1.1.1", code: goval.StringIP},
+		{desc: "host name", validator: goval.String().IP(), input: "example.com", code: goval.StringIP},
+		{desc: "ipv4 only", validator: goval.String().IPv4(), input: "10.0.0.1"},
+		{desc: "ipv4 rejects ipv6", validator: goval.String().IPv4(), input: "::1", code: goval.StringIPv4},
+		{desc: "ipv4 rejects mapped", validator: goval.String().IPv4(), input: "::ffff:10.0.0.1", code: goval.StringIPv4},
+		{desc: "ipv4 rejects leading zeros", validator: goval.String().IPv4(), input: "010.0.0.1", code: goval.StringIPv4},
+		{desc: "ipv6 only", validator: goval.String().IPv6(), input: "fe80::1%eth0"},
+		{desc: "ipv6 rejects ipv4", validator: goval.String().IPv6(), input: "10.0.0.1", code: goval.StringIPv6},
+	})
+}
+
+func TestStringValidator_CIDR(t *testing.T) {
+	validator := goval.String().CIDR()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "ipv4 prefix", validator: validator, input: "10.0.0.0/8"},
+		{desc: "ipv6 prefix", validator: validator, input: "2001:db8::/32"},
+		{desc: "no mask", validator: validator, input: "10.0.0.0", code: goval.StringCIDR},
+		{desc: "mask too long", validator: validator, input: "10.0.0.0/33", code: goval.StringCIDR},
+	})
+}
+
+func TestStringValidator_IPInPrefix(t *testing.T) {
+	prefixes := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("2001:db8::/32")}
+	v := goval.String().IPInPrefix(prefixes...)
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "in ipv4 prefix", validator: v, input: "10.1.2.3"},
+		{desc: "in ipv6 prefix", validator: v, input: "2001:db8::5"},
+		{desc: "mapped ipv4", validator: v, input: "::ffff:10.1.2.3"},
+		{desc: "outside", validator: v, input: "192.168.1.1", code: goval.StringIPInPrefix, args: []any{prefixes}},
+		{desc: "invalid", validator: v, input: "10.1.2", code: goval.StringIPInPrefix, args: []any{prefixes}},
+	})
+}
+
+func TestStringValidator_HostPort(t *testing.T) {
+	validator := goval.String().HostPort()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "host name", validator: validator, input: "example.com:443"},
+		{desc: "ipv4", validator: validator, input: "127.0.0.1:8080"},
+		{desc: "ipv6", validator: validator, input: "[::1]:80"},
+		{desc: "missing port", validator: validator, input: "example.com", code: goval.StringHostPort},
+		{desc: "port out of range", validator: validator, input: "example.com:65536", code: goval.StringHostPort},
+		{desc: "named port", validator: validator, input: "example.com:https", code: goval.StringHostPort},
+		{desc: "empty host", validator: validator, input: ":80", code: goval.StringHostPort},
+		{desc: "invalid host", validator: validator, input: "exa_mple.com:80", code: goval.StringHostPort},
+	})
+}
+
+func TestStringValidator_MAC(t *testing.T) {
+	validator := goval.String().MAC()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "colon", validator: validator, input: "00:00:5e:00:53:01"},
+		{desc: "hyphen", validator: validator, input: "00-00-5E-00-53-01"},
+		{desc: "dot", validator: validator, input: "0000.5e00.5301"},
+		{desc: "invalid", validator: validator, input: "00:00:5e:00:53", code: goval.StringMAC},
+	})
+}
+
+func TestStringValidator_PublicIP(t *testing.T) {
+	ctx := context.Background()
+	validator := goval.String().PublicIP()
+	for _, addr := range []string{"8.8.8.8", "2606:4700:4700::1111"} {
+		if err := validator.Validate(ctx, addr); err != nil {
+			t.Errorf("%s: expect no error; got error: %v", addr, err)
+		}
+	}
+
+	for _, addr := range []string{
+		"10.0.0.1", "172.16.0.1", "192.168.0.1", "127.0.0.1", "169.254.169.254", "0.0.0.0", "100.64.0.1",
+		"224.0.0.1", "255.255.255.255", "192.0.2.1", "::1", "::", "fe80::1", "fc00::1", "ff02::1",
+		"::ffff:127.0.0.1", "64:ff9b::a00:1", "2001:db8::1", "not-an-ip",
+	} {
+		err := validator.Validate(ctx, addr)
+		var exp *goval.RuleError
+		if !errors.As(err, &exp) {
+			t.Fatalf("%s: expect error type: %T; got error type: %T", addr, exp, err)
+		}
+
+		if !exp.Code.Equal(goval.StringPublicIP) {
+			t.Errorf("%s: expect the error code: %v; got error code: %v", addr, goval.StringPublicIP, exp.Code)
+		}
+
+		if exp.Args != nil {
+			t.Errorf("%s: expect the error args is empty; got error args: %v", addr, exp.Args)
+		}
+	}
+}
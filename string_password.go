@@ -0,0 +1,343 @@
+package goval
+
+import (
+	"bufio"
+	"context"
+	_ "embed"
+	"math"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:embed data/common_passwords.txt
+var commonPasswordsFile string
+
+var commonPasswords map[string]struct{}
+var commonPasswordsOnce sync.Once
+
+// PasswordPolicy is a builder of the password requirements, the zero value has no requirement.
+// Each method returns a copy of the policy with the requirement added, so a base policy can be shared safely.
+//
+//	policy := goval.Password().MinLength(12).RequireLower().RequireUpper().RequireDigit().ForbidCommon()
+//	goval.Named("password", req.Password, goval.String().Required().Password(policy.ForbidIdentity(req.Email)))
+type PasswordPolicy struct {
+	minLength    int
+	classes      []passwordClass
+	maxRepeat    int
+	maxSequence  int
+	minEntropy   float64
+	identities   []string
+	forbidCommon bool
+	allRules     bool
+}
+
+// Password returns a PasswordPolicy with no requirement.
+func Password() PasswordPolicy {
+	return PasswordPolicy{}
+}
+
+// MinLength requires the password to have at least the given number of runes.
+func (p PasswordPolicy) MinLength(length int) PasswordPolicy {
+	p.minLength = length
+	return p
+}
+
+// RequireLower requires the password to have at least one lowercase letter.
+func (p PasswordPolicy) RequireLower() PasswordPolicy {
+	return p.withClass(passwordLower)
+}
+
+// RequireUpper requires the password to have at least one uppercase letter.
+func (p PasswordPolicy) RequireUpper() PasswordPolicy {
+	return p.withClass(passwordUpper)
+}
+
+// RequireDigit requires the password to have at least one digit.
+func (p PasswordPolicy) RequireDigit() PasswordPolicy {
+	return p.withClass(passwordDigit)
+}
+
+// RequireSymbol requires the password to have at least one rune that is not a letter, a digit or a space.
+func (p PasswordPolicy) RequireSymbol() PasswordPolicy {
+	return p.withClass(passwordSymbol)
+}
+
+// MaxRepeat limits the runs of the same rune, e.g. MaxRepeat(2) rejects "paaass".
+func (p PasswordPolicy) MaxRepeat(length int) PasswordPolicy {
+	p.maxRepeat = length
+	return p
+}
+
+// MaxSequence limits the runs of consecutive ascending or descending runes, e.g. MaxSequence(3) rejects "abcd" and "4321".
+func (p PasswordPolicy) MaxSequence(length int) PasswordPolicy {
+	p.maxSequence = length
+	return p
+}
+
+// MinEntropy requires the estimated entropy of the password to be at least the given bits.
+// The estimate is the length in runes multiplied by log2 of the size of the character pool used by the password,
+// where the pool is the sum of the classes present: lowercase 26, uppercase 26, digits 10, ASCII symbols 33,
+// and 100 for any other rune. It is an upper bound, combine it with ForbidCommon, MaxRepeat and MaxSequence.
+func (p PasswordPolicy) MinEntropy(bits float64) PasswordPolicy {
+	p.minEntropy = bits
+	return p
+}
+
+// ForbidIdentity rejects the password that contains any of the given identities, compared case-insensitively,
+// typically the username or the email taken from another field. For an email, its local part is also checked.
+// The identities shorter than 3 runes are ignored to avoid false positives.
+func (p PasswordPolicy) ForbidIdentity(identities ...string) PasswordPolicy {
+	p.identities = append(append([]string(nil), p.identities...), identities...)
+	return p
+}
+
+// ForbidCommon rejects the password that is in the embedded list of the common passwords, compared case-insensitively.
+// The list is the top 10,000 passwords of Mark Burnett as shipped by zxcvbn-go, 7141 passwords after its filtering,
+// see data/common_passwords.txt. It is not a breach corpus, check the password against one for a stronger assurance.
+func (p PasswordPolicy) ForbidCommon() PasswordPolicy {
+	p.forbidCommon = true
+	return p
+}
+
+// AllRules makes the policy report every unmet requirement as a separate RuleError in Errors,
+// by default only the first unmet requirement is reported.
+func (p PasswordPolicy) AllRules() PasswordPolicy {
+	p.allRules = true
+	return p
+}
+
+// Validate implements RuleValidator, it checks the password against the policy.
+// With AllRules, each RuleError is translated before it is collected, since the translation
+// of the validators only applies to a single RuleError, not to the Errors.
+func (p PasswordPolicy) Validate(ctx context.Context, value string) error {
+	var errs Errors
+	for _, check := range p.checks() {
+		if err := check(value); err != nil {
+			if !p.allRules {
+				return err
+			}
+			errs = append(errs, translateValidatorError(ctx, err))
+		}
+	}
+	return errs.NilIfEmpty()
+}
+
+// Password ensures that the string satisfies the password policy.
+func (f SVV[T]) Password(policy PasswordPolicy) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		return policy.Validate(ctx, string(value))
+	})
+}
+
+// checks returns the checks of the requirements in the order they are reported.
+func (p PasswordPolicy) checks() []func(value string) error {
+	var checks []func(value string) error
+	if p.minLength > 0 {
+		checks = append(checks, func(value string) error {
+			if utf8.RuneCountInString(value) < p.minLength {
+				return NewRuleError(StringPasswordMin, p.minLength)
+			}
+			return nil
+		})
+	}
+
+	for _, class := range p.classes {
+		class := class
+		checks = append(checks, func(value string) error {
+			if strings.IndexFunc(value, func(r rune) bool { return classOfRune(r) == class }) < 0 {
+				return NewRuleError(class.code())
+			}
+			return nil
+		})
+	}
+
+	if p.maxRepeat > 0 {
+		checks = append(checks, func(value string) error {
+			if longestRun(value, func(prev, r rune) bool { return prev == r }) > p.maxRepeat {
+				return NewRuleError(StringPasswordRepeat, p.maxRepeat)
+			}
+			return nil
+		})
+	}
+
+	if p.maxSequence > 0 {
+		checks = append(checks, func(value string) error {
+			ascending := longestRun(value, func(prev, r rune) bool { return r == prev+1 })
+			descending := longestRun(value, func(prev, r rune) bool { return r == prev-1 })
+			if ascending > p.maxSequence || descending > p.maxSequence {
+				return NewRuleError(StringPasswordSequence, p.maxSequence)
+			}
+			return nil
+		})
+	}
+
+	if p.minEntropy > 0 {
+		checks = append(checks, func(value string) error {
+			if passwordEntropy(value) < p.minEntropy {
+				return NewRuleError(StringPasswordEntropy, p.minEntropy)
+			}
+			return nil
+		})
+	}
+
+	if len(p.identities) > 0 {
+		checks = append(checks, func(value string) error {
+			for _, identity := range p.identities {
+				if containsIdentity(value, identity) {
+					return NewRuleError(StringPasswordIdentity)
+				}
+			}
+			return nil
+		})
+	}
+
+	if p.forbidCommon {
+		checks = append(checks, func(value string) error {
+			if isCommonPassword(value) {
+				return NewRuleError(StringPasswordCommon)
+			}
+			return nil
+		})
+	}
+	return checks
+}
+
+// withClass returns a copy of the policy that requires the class.
+func (p PasswordPolicy) withClass(class passwordClass) PasswordPolicy {
+	for _, c := range p.classes {
+		if c == class {
+			return p
+		}
+	}
+	p.classes = append(append([]passwordClass(nil), p.classes...), class)
+	return p
+}
+
+// passwordClass is a character class of the password requirements.
+type passwordClass int
+
+const (
+	passwordOther passwordClass = iota
+	passwordLower
+	passwordUpper
+	passwordDigit
+	passwordSymbol
+)
+
+// code returns the rule code reported when the class is missing.
+func (c passwordClass) code() ruleCode {
+	switch c {
+	case passwordLower:
+		return StringPasswordLower
+	case passwordUpper:
+		return StringPasswordUpper
+	case passwordDigit:
+		return StringPasswordDigit
+	default:
+		return StringPasswordSymbol
+	}
+}
+
+// classOfRune returns the class of the rune, the spaces are passwordOther.
+func classOfRune(r rune) passwordClass {
+	switch {
+	case unicode.IsLower(r):
+		return passwordLower
+	case unicode.IsUpper(r):
+		return passwordUpper
+	case unicode.IsDigit(r):
+		return passwordDigit
+	case unicode.IsSpace(r) || unicode.IsLetter(r):
+		return passwordOther
+	default:
+		return passwordSymbol
+	}
+}
+
+// longestRun returns the length of the longest run of runes where each rune follows the previous one.
+func longestRun(s string, follows func(prev, r rune) bool) int {
+	longest, run := 0, 0
+	prev := rune(-1)
+	for _, r := range s {
+		if run > 0 && follows(prev, r) {
+			run++
+		} else {
+			run = 1
+		}
+		if run > longest {
+			longest = run
+		}
+		prev = r
+	}
+	return longest
+}
+
+// passwordEntropy estimates the entropy of the password in bits, see PasswordPolicy.MinEntropy.
+func passwordEntropy(s string) float64 {
+	var lower, upper, digit, symbol, other bool
+	n := 0
+	for _, r := range s {
+		n++
+		switch {
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= '0' && r <= '9':
+			digit = true
+		case r >= '!' && r <= '~':
+			symbol = true
+		default:
+			other = true
+		}
+	}
+
+	pool := 0
+	for _, c := range []struct {
+		present bool
+		size    int
+	}{{lower, 26}, {upper, 26}, {digit, 10}, {symbol, 33}, {other, 100}} {
+		if c.present {
+			pool += c.size
+		}
+	}
+
+	if pool == 0 {
+		return 0
+	}
+	return float64(n) * math.Log2(float64(pool))
+}
+
+// containsIdentity reports whether the password contains the identity or, for an email, its local part.
+func containsIdentity(password, identity string) bool {
+	candidates := []string{identity}
+	if at := strings.LastIndexByte(identity, '@'); at > 0 {
+		candidates = append(candidates, identity[:at])
+	}
+
+	for _, candidate := range candidates {
+		if utf8.RuneCountInString(candidate) >= 3 && containsFoldString(password, candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+// isCommonPassword reports whether the password is in the embedded list of the common passwords.
+func isCommonPassword(password string) bool {
+	commonPasswordsOnce.Do(func() {
+		commonPasswords = make(map[string]struct{})
+		scanner := bufio.NewScanner(strings.NewReader(commonPasswordsFile))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line != "" && !strings.HasPrefix(line, "#") {
+				commonPasswords[line] = struct{}{}
+			}
+		}
+	})
+
+	_, ok := commonPasswords[strings.ToLower(password)]
+	return ok
+}
@@ -0,0 +1,19 @@
+package goval
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCommonPasswords(t *testing.T) {
+	isCommonPassword("")
+	if len(commonPasswords) != 7141 {
+		t.Errorf("expect the list has 7141 passwords, as documented by ForbidCommon; got %d", len(commonPasswords))
+	}
+
+	for password := range commonPasswords {
+		if password != strings.ToLower(password) {
+			t.Errorf("expect the password %q in lowercase, since it is compared case-insensitively", password)
+		}
+	}
+}
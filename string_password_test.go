@@ -0,0 +1,110 @@
+package goval_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/pkg-id/goval"
+)
+
+func TestStringValidator_Password(t *testing.T) {
+	policy := goval.Password().MinLength(10).RequireLower().RequireUpper().RequireDigit().RequireSymbol()
+	v := goval.String().Password(policy)
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "ok", validator: v, input: "Tr0ub4dor&3x"},
+		{desc: "min length in runes", validator: v, input: "Pässw0rd!é"},
+		{desc: "min length", validator: v, input: "Tr0ub&3", code: goval.StringPasswordMin, args: []any{10}},
+		{desc: "lower", validator: v, input: "TR0UB4DOR&3X", code: goval.StringPasswordLower},
+		{desc: "upper", validator: v, input: "tr0ub4dor&3x", code: goval.StringPasswordUpper},
+		{desc: "digit", validator: v, input: "Troubadour&x", code: goval.StringPasswordDigit},
+		{desc: "symbol", validator: v, input: "Tr0ub4dor 3x", code: goval.StringPasswordSymbol},
+		{desc: "repeat ok", validator: goval.String().Password(goval.Password().MaxRepeat(2)), input: "paass"},
+		{desc: "repeat", validator: goval.String().Password(goval.Password().MaxRepeat(2)), input: "paaass", code: goval.StringPasswordRepeat, args: []any{2}},
+		{desc: "sequence ok", validator: goval.String().Password(goval.Password().MaxSequence(3)), input: "abc-321"},
+		{
+			desc:      "ascending sequence",
+			validator: goval.String().Password(goval.Password().MaxSequence(3)),
+			input:     "xabcdx",
+			code:      goval.StringPasswordSequence,
+			args:      []any{3},
+		},
+		{desc: "descending sequence", validator: goval.String().Password(goval.Password().MaxSequence(3)), input: "p4321", code: goval.StringPasswordSequence, args: []any{3}},
+		{desc: "entropy ok", validator: goval.String().Password(goval.Password().MinEntropy(60)), input: "correct horse battery"},
+		{
+			desc:      "entropy",
+			validator: goval.String().Password(goval.Password().MinEntropy(60)),
+			input:     "abcdefgh",
+			code:      goval.StringPasswordEntropy,
+			args:      []any{60.0},
+		},
+		{
+			desc:      "identity",
+			validator: goval.String().Password(goval.Password().ForbidIdentity("bob.smith@example.com")),
+			input:     "xBob.Smith2024",
+			code:      goval.StringPasswordIdentity,
+		},
+		{desc: "short identity is ignored", validator: goval.String().Password(goval.Password().ForbidIdentity("al")), input: "always"},
+		{desc: "common", validator: goval.String().Password(goval.Password().ForbidCommon()), input: "LetMeIn", code: goval.StringPasswordCommon},
+		{desc: "not common", validator: goval.String().Password(goval.Password().ForbidCommon()), input: "violet-anchor-93"},
+	})
+}
+
+func TestPasswordPolicy_AllRules(t *testing.T) {
+	base := goval.Password().MinLength(12).RequireUpper().RequireDigit().ForbidCommon()
+	err := base.AllRules().Validate(context.Background(), "password")
+
+	var errs goval.Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expect Errors; got %T: %v", err, err)
+	}
+
+	expected := []goval.RuleCoder{goval.StringPasswordMin, goval.StringPasswordUpper, goval.StringPasswordDigit, goval.StringPasswordCommon}
+	if len(errs) != len(expected) {
+		t.Fatalf("expect %d errors; got %v", len(expected), errs)
+	}
+	for i, code := range expected {
+		var ruleErr *goval.RuleError
+		if !errors.As(errs[i], &ruleErr) || !ruleErr.Code.Equal(code) {
+			t.Errorf("expect error %d to be %v; got %v", i, code, errs[i])
+		}
+	}
+
+	err = base.Validate(context.Background(), "password")
+	var ruleErr *goval.RuleError
+	if !errors.As(err, &ruleErr) || !ruleErr.Code.Equal(goval.StringPasswordMin) {
+		t.Errorf("expect only the first unmet requirement; got %v", err)
+	}
+}
+
+type codeTranslator struct{}
+
+func (codeTranslator) Translate(_ context.Context, err *goval.RuleError) error {
+	return goval.TextError(fmt.Sprint(err.Code))
+}
+
+func TestPasswordPolicy_AllRulesTranslated(t *testing.T) {
+	goval.SetErrorTranslator(codeTranslator{})
+	defer goval.SetErrorTranslator(goval.DefaultErrorTranslator)
+
+	validator := goval.String().Password(goval.Password().MinLength(12).RequireDigit().AllRules())
+	err := goval.Execute(context.Background(), goval.Bind[string]("password", validator))
+
+	var errs goval.Errors
+	if !errors.As(err, &errs) || len(errs) != 1 {
+		t.Fatalf("expect one error of the validator; got %v", err)
+	}
+
+	var policyErrs goval.Errors
+	if !errors.As(errs[0], &policyErrs) || len(policyErrs) != 2 {
+		t.Fatalf("expect the errors of the policy; got %v", errs[0])
+	}
+
+	for i, code := range []goval.RuleCoder{goval.StringPasswordMin, goval.StringPasswordDigit} {
+		expected := goval.TextError(fmt.Sprint(code))
+		if policyErrs[i] != expected {
+			t.Errorf("expect error %d is translated to %q; got %#v", i, expected, policyErrs[i])
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package goval
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxFilenameLength is the maximum length of a file name in bytes, the limit of the common file systems.
+const maxFilenameLength = 255
+
+// SafeFilename ensures that the string is a file name that is safe to create on the common file systems.
+// It rejects the names that:
+//   - are empty, ".", "..", end with a dot or a space, or are not valid UTF-8, reported as StringFilename,
+//   - are longer than 255 bytes, reported as StringFilenameLength,
+//   - contain a path separator, either "/" or "\", reported as StringFilenameSeparator,
+//   - contain a control character or one of `<>:"|?*`, reported as StringFilenameCharacter,
+//   - are reserved by Windows, such as "CON" or "com1.txt", reported as StringFilenameReserved.
+func (f SVV[T]) SafeFilename() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		return validateFilename(string(value))
+	})
+}
+
+// RelativePath ensures that the string is a relative slash-separated path that stays within its base directory,
+// that is, after path.Clean it neither is ".." nor starts with "../". For example, "a/../b" is accepted,
+// but "a/../../b" is reported as StringPathTraversal.
+//
+// It returns StringRelativePath if the path is empty, absolute, or contains a backslash or a NUL byte,
+// since a backslash is a separator on Windows, so it could hide a traversal from path.Clean.
+// A path that is cleaned to ".", e.g. "./" or "a/..", is the base directory itself, so it is rejected as well.
+func (f SVV[T]) RelativePath() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		p := string(value)
+		if p == "" || strings.HasPrefix(p, "/") || strings.ContainsAny(p, "\\\x00") || isVolumeName(p) {
+			return NewRuleError(StringRelativePath)
+		}
+
+		cleaned := path.Clean(p)
+		if cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+			return NewRuleError(StringPathTraversal)
+		}
+
+		if cleaned == "." {
+			return NewRuleError(StringRelativePath)
+		}
+		return nil
+	})
+}
+
+// ExtensionIn ensures that the base name of the path ends with one of the extensions, compared case-insensitively.
+// The extensions may be given with or without the leading dot and may have several parts, e.g. "tar.gz".
+// A name that is only the extension, e.g. ".gz", has no extension.
+func (f SVV[T]) ExtensionIn(exts ...string) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		base := path.Base(string(value))
+		for _, ext := range exts {
+			if !strings.HasPrefix(ext, ".") {
+				ext = "." + ext
+			}
+			if len(base) > len(ext) && hasSuffixFold(base, ext) {
+				return nil
+			}
+		}
+		return NewRuleError(StringExtensionIn, exts)
+	})
+}
+
+// ExistsIn ensures that the path exists in the file system, the path must be valid by fs.ValidPath,
+// e.g. "static/logo.png". Use fstest.MapFS to test it, or os.DirFS to check a directory on the disk.
+//
+// It returns StringExistsIn if the path is not valid or does not exist, and any other error of fs.Stat,
+// e.g. a permission error, as an InternalError.
+func (f SVV[T]) ExistsIn(fsys fs.FS) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		name := string(value)
+		if !fs.ValidPath(name) {
+			return NewRuleError(StringExistsIn)
+		}
+
+		if _, err := fs.Stat(fsys, name); err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return NewRuleError(StringExistsIn)
+			}
+			return NewInternalError(err)
+		}
+		return nil
+	})
+}
+
+// validateFilename checks the file name, see SVV.SafeFilename.
+func validateFilename(name string) error {
+	if name == "" || name == "." || name == ".." || strings.HasSuffix(name, ".") || strings.HasSuffix(name, " ") ||
+		!utf8.ValidString(name) {
+		return NewRuleError(StringFilename)
+	}
+
+	if len(name) > maxFilenameLength {
+		return NewRuleError(StringFilenameLength, maxFilenameLength)
+	}
+
+	if strings.ContainsAny(name, "/\\") {
+		return NewRuleError(StringFilenameSeparator)
+	}
+
+	for _, r := range name {
+		if unicode.IsControl(r) || strings.ContainsRune(`<>:"|?*`, r) {
+			return NewRuleError(StringFilenameCharacter, string(r))
+		}
+	}
+
+	if isReservedFilename(name) {
+		return NewRuleError(StringFilenameReserved, name)
+	}
+	return nil
+}
+
+// isReservedFilename reports whether the name, without its extensions, is a device name reserved by Windows.
+func isReservedFilename(name string) bool {
+	stem := strings.ToUpper(strings.TrimRight(strings.SplitN(name, ".", 2)[0], " "))
+	switch stem {
+	case "CON", "PRN", "AUX", "NUL":
+		return true
+	}
+
+	if len(stem) == 4 && (strings.HasPrefix(stem, "COM") || strings.HasPrefix(stem, "LPT")) {
+		return stem[3] >= '1' && stem[3] <= '9'
+	}
+	return false
+}
+
+// isVolumeName reports whether the path starts with a Windows drive letter, e.g. "C:".
+func isVolumeName(p string) bool {
+	return len(p) >= 2 && p[1] == ':' && ('a' <= p[0] && p[0] <= 'z' || 'A' <= p[0] && p[0] <= 'Z')
+}
@@ -0,0 +1,107 @@
+package goval_test
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/pkg-id/goval"
+)
+
+func TestStringValidator_SafeFilename(t *testing.T) {
+	validator := goval.String().SafeFilename()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "plain", validator: validator, input: "report-2024.pdf"},
+		{desc: "hidden file", validator: validator, input: ".env"},
+		{desc: "unicode", validator: validator, input: "laporan keuangan ñ.xlsx"},
+		{desc: "max length", validator: validator, input: strings.Repeat("a", 255)},
+		{desc: "empty", validator: validator, input: "", code: goval.StringFilename},
+		{desc: "dot", validator: validator, input: ".", code: goval.StringFilename},
+		{desc: "dot dot", validator: validator, input: "..", code: goval.StringFilename},
+		{desc: "trailing dot", validator: validator, input: "file.", code: goval.StringFilename},
+		{desc: "trailing space", validator: validator, input: "file.txt ", code: goval.StringFilename},
+		{desc: "invalid utf-8", validator: validator, input: "file\xff.txt", code: goval.StringFilename},
+		{desc: "too long", validator: validator, input: strings.Repeat("a", 256), code: goval.StringFilenameLength, args: []any{255}},
+		{desc: "slash", validator: validator, input: "../etc/passwd", code: goval.StringFilenameSeparator},
+		{desc: "backslash", validator: validator, input: `..\boot.ini`, code: goval.StringFilenameSeparator},
+		{desc: "nul", validator: validator, input: "file\x00.txt", code: goval.StringFilenameCharacter, args: []any{"\x00"}},
+		{desc: "newline", validator: validator, input: "a\nb", code: goval.StringFilenameCharacter, args: []any{"\n"}},
+		{desc: "colon", validator: validator, input: "a:b", code: goval.StringFilenameCharacter, args: []any{":"}},
+		{desc: "wildcard", validator: validator, input: "*.txt", code: goval.StringFilenameCharacter, args: []any{"*"}},
+		{desc: "reserved", validator: validator, input: "CON", code: goval.StringFilenameReserved, args: []any{"CON"}},
+		{desc: "reserved with extension", validator: validator, input: "com1.tar.gz", code: goval.StringFilenameReserved, args: []any{"com1.tar.gz"}},
+		{desc: "reserved lowercase", validator: validator, input: "nul.txt", code: goval.StringFilenameReserved, args: []any{"nul.txt"}},
+		{desc: "not reserved", validator: validator, input: "console.log"},
+		{desc: "com0 is not reserved", validator: validator, input: "com0"},
+	})
+}
+
+func TestStringValidator_RelativePath(t *testing.T) {
+	validator := goval.String().RelativePath()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "file", validator: validator, input: "exports/2024/report.csv"},
+		{desc: "inner dot dot", validator: validator, input: "a/b/../c"},
+		{desc: "dot dot in name", validator: validator, input: "a/..b/c"},
+		{desc: "empty", validator: validator, input: "", code: goval.StringRelativePath},
+		{desc: "absolute", validator: validator, input: "/etc/passwd", code: goval.StringRelativePath},
+		{desc: "backslash", validator: validator, input: `a\..\..\b`, code: goval.StringRelativePath},
+		{desc: "drive", validator: validator, input: "C:/Windows", code: goval.StringRelativePath},
+		{desc: "nul", validator: validator, input: "a\x00b", code: goval.StringRelativePath},
+		{desc: "dot", validator: validator, input: ".", code: goval.StringRelativePath},
+		{desc: "dot slash", validator: validator, input: "./", code: goval.StringRelativePath},
+		{desc: "back to base", validator: validator, input: "a/..", code: goval.StringRelativePath},
+		{desc: "dot dot", validator: validator, input: "..", code: goval.StringPathTraversal},
+		{desc: "leading dot dot", validator: validator, input: "../secret", code: goval.StringPathTraversal},
+		{desc: "escapes after clean", validator: validator, input: "a/../../b", code: goval.StringPathTraversal},
+		{desc: "hidden by dots", validator: validator, input: "./a/./../../b", code: goval.StringPathTraversal},
+	})
+}
+
+func TestStringValidator_ExtensionIn(t *testing.T) {
+	validator := goval.String().ExtensionIn(".png", "jpg", ".tar.gz")
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "with dot", validator: validator, input: "logo.png"},
+		{desc: "without dot", validator: validator, input: "photo.jpg"},
+		{desc: "case-insensitive", validator: validator, input: "PHOTO.JPG"},
+		{desc: "several parts", validator: validator, input: "backup.tar.gz"},
+		{desc: "in a directory", validator: validator, input: "images/logo.png"},
+		{desc: "other", validator: validator, input: "script.js", code: goval.StringExtensionIn, args: []any{[]string{".png", "jpg", ".tar.gz"}}},
+		{desc: "double extension", validator: validator, input: "logo.png.exe", code: goval.StringExtensionIn, args: []any{[]string{".png", "jpg", ".tar.gz"}}},
+		{desc: "only extension", validator: validator, input: ".png", code: goval.StringExtensionIn, args: []any{[]string{".png", "jpg", ".tar.gz"}}},
+		{desc: "extension in directory", validator: validator, input: "a.png/file", code: goval.StringExtensionIn, args: []any{[]string{".png", "jpg", ".tar.gz"}}},
+	})
+}
+
+func TestStringValidator_ExistsIn(t *testing.T) {
+	fsys := fstest.MapFS{
+		"static/logo.png":  {Data: []byte("png")},
+		"templates/a.tmpl": {Data: []byte("{{.}}")},
+	}
+	validator := goval.String().ExistsIn(fsys)
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "file", validator: validator, input: "static/logo.png"},
+		{desc: "directory", validator: validator, input: "templates"},
+		{desc: "root", validator: validator, input: "."},
+		{desc: "missing", validator: validator, input: "static/icon.png", code: goval.StringExistsIn},
+		{desc: "absolute", validator: validator, input: "/static/logo.png", code: goval.StringExistsIn},
+		{desc: "traversal", validator: validator, input: "static/../static/logo.png", code: goval.StringExistsIn},
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		err := goval.String().ExistsIn(errorFS{}).Validate(context.Background(), "a")
+		var internalErr *goval.InternalError
+		if !errors.As(err, &internalErr) || !errors.Is(err, fs.ErrPermission) {
+			t.Errorf("expect an InternalError of fs.ErrPermission; got %v", err)
+		}
+	})
+}
+
+// errorFS is a file system that denies any access.
+type errorFS struct{}
+
+func (errorFS) Open(name string) (fs.File, error) {
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrPermission}
+}
@@ -0,0 +1,309 @@
+package goval
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// RestrictionLevel is the restriction level of UTS #39, Unicode Security Mechanisms, that limits which scripts
+// may be mixed in a token of SafeText. The characters of the Common and Inherited scripts, such as the digits,
+// the punctuation and the combining marks, may be used with any script.
+//
+// The levels are ordered from the strictest to the loosest, each level allows the tokens of the stricter levels.
+// The zero value is not a level.
+type RestrictionLevel int
+
+const (
+	// ASCIIOnly allows only the ASCII characters.
+	ASCIIOnly RestrictionLevel = iota + 1
+	// SingleScript allows the characters of a single script per token. Han with Hiragana and Katakana,
+	// Han with Bopomofo, and Han with Hangul are single scripts, as they are written together.
+	SingleScript
+	// HighlyRestrictive allows the tokens of SingleScript, and Latin mixed with Han and Hiragana and Katakana,
+	// with Han and Bopomofo, or with Han and Hangul.
+	HighlyRestrictive
+	// ModeratelyRestrictive allows the tokens of HighlyRestrictive, and Latin mixed with one other script,
+	// except Cyrillic and Greek, whose letters are the most confusable with Latin, e.g. "Αpple" is rejected.
+	// It is the level of DefaultSafeTextOptions, as it is the level recommended by UTS #39 for the identifiers.
+	ModeratelyRestrictive
+	// MinimallyRestrictive allows any mixture of scripts, only the characters are checked.
+	MinimallyRestrictive
+)
+
+// SafeTextOptions is the policy of the SafeText rule, see DefaultSafeTextOptions.
+type SafeTextOptions struct {
+	// Level limits the mixture of scripts in a token, it must be one of the restriction levels.
+	Level RestrictionLevel
+	// AllowJoiners allows the zero width joiner U+200D and non-joiner U+200C, which are required
+	// by the emoji sequences, e.g. the woman technologist emoji, and by some scripts, such as Persian.
+	AllowJoiners bool
+}
+
+// DefaultSafeTextOptions returns the recommended policy, ModeratelyRestrictive without joiners.
+func DefaultSafeTextOptions() SafeTextOptions {
+	return SafeTextOptions{Level: ModeratelyRestrictive}
+}
+
+// SafeText ensures that the string is safe to display as a user supplied name, e.g. a display name
+// or a repository name, that is, it has no hidden characters and no mixed-script homoglyphs, as described
+// by UTS #39, Unicode Security Mechanisms. The string is split into tokens by white space, and it rejects:
+//   - the bidirectional controls, such as the right-to-left override U+202E used by the "Trojan Source" attacks,
+//     reported as StringSafeTextBidi,
+//   - the control, format, private use and unassigned characters, the line and paragraph separators,
+//     the default ignorable characters, such as the Hangul filler U+3164 and the variation selectors,
+//     the Braille blank U+2800, the joiners unless allowed, and the invalid UTF-8,
+//     reported as StringSafeTextCharacter, although the emoji variation selectors U+FE0E and U+FE0F
+//     are allowed right after an emoji, e.g. "❤\uFE0F",
+//   - the tokens that mix scripts beyond the restriction level, e.g. "pаypal" with the Cyrillic "а" U+0430,
+//     reported as StringSafeTextMixedScript with the script of the offending rune.
+//
+// Each error has the offending rune and its byte offset, the rune of the invalid UTF-8 is utf8.RuneError.
+//
+// It panics if the level is not one of the restriction levels.
+func (f SVV[T]) SafeText(opts SafeTextOptions) SVV[T] {
+	if opts.Level < ASCIIOnly || opts.Level > MinimallyRestrictive {
+		panic(fmt.Sprintf("goval: invalid restriction level %d", opts.Level))
+	}
+
+	return f.With(func(ctx context.Context, value T) error {
+		return validateSafeText(string(value), opts)
+	})
+}
+
+// validateSafeText checks the characters and the scripts of the tokens from left to right, see SVV.SafeText.
+func validateSafeText(s string, opts SafeTextOptions) error {
+	var token scriptSet
+	var other string // the script of scriptOther in the token.
+	var prev rune
+	for i, r := range s {
+		last := prev
+		prev = r
+		if r == utf8.RuneError {
+			if _, size := utf8.DecodeRuneInString(s[i:]); size == 1 {
+				return NewRuleError(StringSafeTextCharacter, r, i)
+			}
+		}
+
+		if unicode.Is(unicode.Bidi_Control, r) {
+			return NewRuleError(StringSafeTextBidi, r, i)
+		}
+
+		if r == '\u200c' || r == '\u200d' {
+			if !opts.AllowJoiners {
+				return NewRuleError(StringSafeTextCharacter, r, i)
+			}
+			continue
+		}
+
+		if (r == '\ufe0e' || r == '\ufe0f') && isEmojiBase(last) {
+			continue
+		}
+
+		if !isSafeTextRune(r) {
+			return NewRuleError(StringSafeTextCharacter, r, i)
+		}
+
+		if unicode.IsSpace(r) {
+			token, other = 0, ""
+			continue
+		}
+
+		if opts.Level == ASCIIOnly {
+			if r >= utf8.RuneSelf {
+				return NewRuleError(StringSafeTextMixedScript, r, i, scriptOf(r))
+			}
+			continue
+		}
+
+		script := scriptOf(r)
+		bit := scriptBit(script)
+		if bit == scriptOther && other != "" && other != script {
+			// two other scripts are never a single script, nor a mixture with Latin.
+			if opts.Level != MinimallyRestrictive {
+				return NewRuleError(StringSafeTextMixedScript, r, i, script)
+			}
+			continue
+		}
+
+		if next := token | bit; next != token {
+			if !next.allowedAt(opts.Level) {
+				return NewRuleError(StringSafeTextMixedScript, r, i, script)
+			}
+			token = next
+		}
+		if bit == scriptOther {
+			other = script
+		}
+	}
+	return nil
+}
+
+// isSafeTextRune reports whether the rune is a letter, a mark, a number, a punctuation, a symbol or a space
+// separator, and is neither default ignorable nor the Braille blank U+2800, so it is not rendered as nothing.
+// The Default_Ignorable_Code_Point property is the format characters, which are not in the categories above,
+// the variation selectors, and Other_Default_Ignorable_Code_Point, e.g. the Hangul filler U+3164.
+func isSafeTextRune(r rune) bool {
+	return unicode.In(r, unicode.L, unicode.M, unicode.N, unicode.P, unicode.S, unicode.Zs) &&
+		!unicode.In(r, unicode.Variation_Selector, unicode.Other_Default_Ignorable_Code_Point) && r != '\u2800'
+}
+
+// emojiBases are the emoji with a variation sequence that are not other symbols, such as the keycap bases.
+var emojiBases = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0023, Hi: 0x0023, Stride: 1}, // number sign
+		{Lo: 0x002a, Hi: 0x002a, Stride: 1}, // asterisk
+		{Lo: 0x0030, Hi: 0x0039, Stride: 1}, // digits
+		{Lo: 0x203c, Hi: 0x203c, Stride: 1}, // double exclamation mark
+		{Lo: 0x2049, Hi: 0x2049, Stride: 1}, // exclamation question mark
+		{Lo: 0x2139, Hi: 0x2139, Stride: 1}, // information source
+		{Lo: 0x2194, Hi: 0x2194, Stride: 1}, // left right arrow
+		{Lo: 0x25fb, Hi: 0x25fe, Stride: 1}, // medium and medium small squares
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1}, // curved arrows
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1}, // wavy dash
+		{Lo: 0x303d, Hi: 0x303d, Stride: 1}, // part alternation mark
+	},
+}
+
+// isEmojiBase reports whether the rune may be followed by an emoji variation selector, that is,
+// it is an other symbol, as most emoji are, or one of the emojiBases.
+func isEmojiBase(r rune) bool {
+	return unicode.In(r, unicode.So, emojiBases)
+}
+
+// scriptRange is a range of the runes of a script.
+type scriptRange struct {
+	lo, hi rune
+	name   string
+}
+
+// scriptRanges are the ranges of unicode.Scripts sorted by the runes, built once by scriptOf.
+var scriptRanges []scriptRange
+var scriptRangesOnce sync.Once
+
+// scriptOf returns the name of the script of the rune, e.g. "Latin", or "Common" if it has none.
+func scriptOf(r rune) string {
+	switch {
+	case r < utf8.RuneSelf:
+		if 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' {
+			return "Latin"
+		}
+		return "Common"
+	case unicode.Is(unicode.Latin, r):
+		return "Latin"
+	}
+
+	scriptRangesOnce.Do(func() {
+		for name, table := range unicode.Scripts {
+			for _, rng := range table.R16 {
+				scriptRanges = appendScriptRange(scriptRanges, rune(rng.Lo), rune(rng.Hi), rune(rng.Stride), name)
+			}
+			for _, rng := range table.R32 {
+				scriptRanges = appendScriptRange(scriptRanges, rune(rng.Lo), rune(rng.Hi), rune(rng.Stride), name)
+			}
+		}
+		sort.Slice(scriptRanges, func(i, j int) bool { return scriptRanges[i].lo < scriptRanges[j].lo })
+	})
+
+	i := sort.Search(len(scriptRanges), func(i int) bool { return scriptRanges[i].hi >= r })
+	if i < len(scriptRanges) && scriptRanges[i].lo <= r {
+		return scriptRanges[i].name
+	}
+	return "Common"
+}
+
+// appendScriptRange appends the range of the script, a range with a stride is split into its runes.
+func appendScriptRange(ranges []scriptRange, lo, hi, stride rune, name string) []scriptRange {
+	if stride == 1 {
+		return append(ranges, scriptRange{lo: lo, hi: hi, name: name})
+	}
+	for r := lo; r <= hi; r += stride {
+		ranges = append(ranges, scriptRange{lo: r, hi: r, name: name})
+	}
+	return ranges
+}
+
+// scriptSet is a set of the scripts of a token, any script without its own bit is scriptOther.
+type scriptSet uint
+
+const (
+	scriptLatin scriptSet = 1 << iota
+	scriptCyrillic
+	scriptGreek
+	scriptHan
+	scriptHiragana
+	scriptKatakana
+	scriptBopomofo
+	scriptHangul
+	scriptOther
+)
+
+// cjkScripts are the combinations of the scripts that are written together, so they are a single script.
+var cjkScripts = []scriptSet{
+	scriptHan | scriptHiragana | scriptKatakana,
+	scriptHan | scriptBopomofo,
+	scriptHan | scriptHangul,
+}
+
+// scriptBit returns the bit of the script, or 0 for Common and Inherited, which are used with any script.
+func scriptBit(script string) scriptSet {
+	switch script {
+	case "Common", "Inherited":
+		return 0
+	case "Latin":
+		return scriptLatin
+	case "Cyrillic":
+		return scriptCyrillic
+	case "Greek":
+		return scriptGreek
+	case "Han":
+		return scriptHan
+	case "Hiragana":
+		return scriptHiragana
+	case "Katakana":
+		return scriptKatakana
+	case "Bopomofo":
+		return scriptBopomofo
+	case "Hangul":
+		return scriptHangul
+	default:
+		return scriptOther
+	}
+}
+
+// allowedAt reports whether the scripts are allowed in a token at the restriction level.
+func (s scriptSet) allowedAt(level RestrictionLevel) bool {
+	if level == MinimallyRestrictive || s.isSingle() {
+		return true
+	}
+	if level == SingleScript || s&scriptLatin == 0 {
+		return false
+	}
+
+	rest := s &^ scriptLatin
+	if rest.isSingle() && (level == ModeratelyRestrictive && rest&(scriptCyrillic|scriptGreek) == 0) {
+		return true
+	}
+	for _, cjk := range cjkScripts {
+		if rest&^cjk == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// isSingle reports whether the set has at most one script, or one of the cjkScripts.
+func (s scriptSet) isSingle() bool {
+	if s&(s-1) == 0 {
+		return true
+	}
+	for _, cjk := range cjkScripts {
+		if s&^cjk == 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package goval
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestScriptOf(t *testing.T) {
+	for name, table := range unicode.Scripts {
+		for _, rng := range table.R16 {
+			for r := rune(rng.Lo); r <= rune(rng.Hi); r += rune(rng.Stride) {
+				if got := scriptOf(r); got != name {
+					t.Fatalf("expect the script of %U is %s; got %s", r, name, got)
+				}
+			}
+		}
+		for _, rng := range table.R32 {
+			for r := rune(rng.Lo); r <= rune(rng.Hi); r += rune(rng.Stride) {
+				if got := scriptOf(r); got != name {
+					t.Fatalf("expect the script of %U is %s; got %s", r, name, got)
+				}
+			}
+		}
+	}
+
+	for _, r := range []rune{0x0378, 0x10ffff, 0xe000} {
+		if got := scriptOf(r); got != "Common" {
+			t.Errorf("expect the script of the unassigned %U is Common; got %s", r, got)
+		}
+	}
+}
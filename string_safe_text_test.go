@@ -0,0 +1,86 @@
+package goval_test
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/pkg-id/goval"
+)
+
+func TestStringValidator_SafeText(t *testing.T) {
+	moderate := goval.String().SafeText(goval.DefaultSafeTextOptions())
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "latin", validator: moderate, input: "Jane O'Neil-Smith 2nd"},
+		{desc: "empty", validator: moderate, input: ""},
+		{desc: "accents", validator: moderate, input: "Zoë Ångström"},
+		{desc: "cyrillic token", validator: moderate, input: "Иван Petrov"},
+		{desc: "japanese", validator: moderate, input: "ひらがなカタカナ漢字"},
+		{desc: "latin and japanese", validator: moderate, input: "Go言語"},
+		{desc: "latin and arabic", validator: moderate, input: "abcعربي"},
+		{desc: "emoji", validator: moderate, input: "ship it 🚀"},
+		{desc: "combining mark", validator: moderate, input: "é"},
+		{desc: "ideographic space", validator: moderate, input: "山田\u3000太郎"},
+		{desc: "emoji presentation", validator: moderate, input: "I \u2764\ufe0f Go"},
+		{desc: "text presentation", validator: moderate, input: "\u2764\ufe0e"},
+		{desc: "keycap", validator: moderate, input: "1\ufe0f\u20e3"},
+		{desc: "homoglyph", validator: moderate, input: "pаypal", code: goval.StringSafeTextMixedScript, args: []any{'а', 1, "Cyrillic"}},
+		{desc: "greek homoglyph", validator: moderate, input: "Αpple", code: goval.StringSafeTextMixedScript, args: []any{'p', 2, "Latin"}},
+		{desc: "two other scripts", validator: moderate, input: "عربيไทย", code: goval.StringSafeTextMixedScript, args: []any{'ไ', 8, "Thai"}},
+		{desc: "rtl override", validator: moderate, input: "invoice\u202egpj.exe", code: goval.StringSafeTextBidi, args: []any{'\u202e', 7}},
+		{desc: "isolate", validator: moderate, input: "a\u2066b", code: goval.StringSafeTextBidi, args: []any{'\u2066', 1}},
+		{desc: "zero width joiner", validator: moderate, input: "ad\u200dmin", code: goval.StringSafeTextCharacter, args: []any{'\u200d', 2}},
+		{desc: "zero width space", validator: moderate, input: "ad\u200bmin", code: goval.StringSafeTextCharacter, args: []any{'\u200b', 2}},
+		{desc: "hangul filler", validator: moderate, input: "\u3164", code: goval.StringSafeTextCharacter, args: []any{'\u3164', 0}},
+		{desc: "variation selector", validator: moderate, input: "ad\ufe00min", code: goval.StringSafeTextCharacter, args: []any{'\ufe00', 2}},
+		{desc: "emoji selector after letter", validator: moderate, input: "ad\ufe0fmin", code: goval.StringSafeTextCharacter, args: []any{'\ufe0f', 2}},
+		{desc: "repeated emoji selector", validator: moderate, input: "\u2764\ufe0f\ufe0f", code: goval.StringSafeTextCharacter, args: []any{'\ufe0f', 6}},
+		{desc: "variation selector supplement", validator: moderate, input: "ad\U000E0100min", code: goval.StringSafeTextCharacter, args: []any{'\U000E0100', 2}},
+		{desc: "mongolian selector", validator: moderate, input: "ad\u180bmin", code: goval.StringSafeTextCharacter, args: []any{'\u180b', 2}},
+		{desc: "mongolian selector four", validator: moderate, input: "ad\u180fmin", code: goval.StringSafeTextCharacter, args: []any{'\u180f', 2}},
+		{desc: "combining grapheme joiner", validator: moderate, input: "ad\u034fmin", code: goval.StringSafeTextCharacter, args: []any{'\u034f', 2}},
+		{desc: "braille blank", validator: moderate, input: "x\u2800\u2800y", code: goval.StringSafeTextCharacter, args: []any{'\u2800', 1}},
+		{desc: "control", validator: moderate, input: "a\tb", code: goval.StringSafeTextCharacter, args: []any{'\t', 1}},
+		{desc: "line separator", validator: moderate, input: "a\u2028b", code: goval.StringSafeTextCharacter, args: []any{'\u2028', 1}},
+		{desc: "private use", validator: moderate, input: "x\ue000", code: goval.StringSafeTextCharacter, args: []any{'\ue000', 1}},
+		{desc: "invalid utf8", validator: moderate, input: "ab\xff", code: goval.StringSafeTextCharacter, args: []any{utf8.RuneError, 2}},
+	})
+}
+
+func TestStringValidator_SafeTextLevels(t *testing.T) {
+	level := func(l goval.RestrictionLevel) goval.StringValidator {
+		return goval.String().SafeText(goval.SafeTextOptions{Level: l})
+	}
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "ascii", validator: level(goval.ASCIIOnly), input: "hello-world 1"},
+		{desc: "ascii fails", validator: level(goval.ASCIIOnly), input: "café", code: goval.StringSafeTextMixedScript, args: []any{'é', 3, "Latin"}},
+		{desc: "single script", validator: level(goval.SingleScript), input: "Иван Petrov"},
+		{desc: "single script korean", validator: level(goval.SingleScript), input: "韓國어"},
+		{desc: "single script fails", validator: level(goval.SingleScript), input: "Go言語", code: goval.StringSafeTextMixedScript, args: []any{'言', 2, "Han"}},
+		{desc: "highly restrictive", validator: level(goval.HighlyRestrictive), input: "Go言語"},
+		{
+			desc:      "highly restrictive arabic",
+			validator: level(goval.HighlyRestrictive),
+			input:     "abcعربي",
+			code:      goval.StringSafeTextMixedScript,
+			args:      []any{'ع', 3, "Arabic"},
+		},
+		{desc: "minimally restrictive", validator: level(goval.MinimallyRestrictive), input: "pаypal عربيไทย"},
+		{
+			desc:      "minimally restrictive characters",
+			validator: level(goval.MinimallyRestrictive),
+			input:     "a\u202e",
+			code:      goval.StringSafeTextBidi,
+			args:      []any{'\u202e', 1},
+		},
+		{desc: "joiners allowed", validator: goval.String().SafeText(goval.SafeTextOptions{Level: goval.ModeratelyRestrictive, AllowJoiners: true}), input: "👩\u200d💻 می\u200cخواهم"},
+	})
+}
+
+func TestStringValidator_SafeTextInvalidLevel(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expect a panic")
+		}
+	}()
+	goval.String().SafeText(goval.SafeTextOptions{})
+}
@@ -0,0 +1,223 @@
+package goval
+
+import (
+	"context"
+	"errors"
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+)
+
+// ValidRegexp ensures that the string is a regular expression accepted by regexp.Compile.
+// If maxProgramSize is positive, the compiled program must not have more instructions than it,
+// which bounds the memory and the time a user supplied expression may take, e.g. "(a{30}){30}" has over 900 instructions.
+//
+// It returns StringRegexp with the byte offset where the parser detected the error and the reason,
+// e.g. "invalid escape sequence", and StringRegexpSize with the maximum program size. An unclosed group
+// is detected at the end of the expression, so its offset is the length of the expression.
+func (f SVV[T]) ValidRegexp(maxProgramSize int) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		expr := string(value)
+		re, err := syntax.Parse(expr, syntax.Perl)
+		if err != nil {
+			var syntaxErr *syntax.Error
+			if errors.As(err, &syntaxErr) {
+				return NewRuleError(StringRegexp, regexpErrorOffset(expr, syntaxErr), string(syntaxErr.Code))
+			}
+			return NewRuleError(StringRegexp, 0, err.Error())
+		}
+
+		if maxProgramSize > 0 {
+			prog, err := syntax.Compile(re.Simplify())
+			if err != nil || len(prog.Inst) > maxProgramSize {
+				return NewRuleError(StringRegexpSize, maxProgramSize)
+			}
+		}
+		return nil
+	})
+}
+
+// ValidTemplate ensures that the string is parsable by text/template, where the template may only call
+// the built-in functions, such as "printf" and "len", and the given function names.
+//
+// It returns StringTemplate with the line of the error and the reason, e.g. `function "exec" not defined`.
+func (f SVV[T]) ValidTemplate(funcNames ...string) SVV[T] {
+	funcMap := make(template.FuncMap, len(funcNames))
+	for _, name := range funcNames {
+		funcMap[name] = func(...any) any { return nil }
+	}
+
+	return f.With(func(ctx context.Context, value T) error {
+		if _, err := template.New("").Funcs(funcMap).Parse(string(value)); err != nil {
+			line, reason := templateErrorPosition(err.Error())
+			return NewRuleError(StringTemplate, line, reason)
+		}
+		return nil
+	})
+}
+
+// JSONPointer ensures that the string is a JSON Pointer, see RFC 6901, e.g. "" or "/items/0/name".
+// It is either empty or a sequence of "/" followed by a reference token, where "~" must be escaped as "~0"
+// and "/" as "~1".
+//
+// It returns StringJSONPointer with the byte offset of the error.
+func (f SVV[T]) JSONPointer() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		pointer := string(value)
+		if pointer != "" && pointer[0] != '/' {
+			return NewRuleError(StringJSONPointer, 0)
+		}
+
+		for i := 0; i < len(pointer); i++ {
+			if pointer[i] == '~' && (i+1 == len(pointer) || pointer[i+1] != '0' && pointer[i+1] != '1') {
+				return NewRuleError(StringJSONPointer, i)
+			}
+		}
+
+		if !utf8.ValidString(pointer) {
+			return NewRuleError(StringJSONPointer, invalidUTF8Offset(pointer))
+		}
+		return nil
+	})
+}
+
+// GlobPattern ensures that the string is a pattern accepted by path.Match, e.g. "*.go" or "img/[a-c]?.png".
+//
+// It returns StringGlob with the byte offset of the malformed escape or character class.
+func (f SVV[T]) GlobPattern() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if offset := globErrorOffset(string(value)); offset >= 0 {
+			return NewRuleError(StringGlob, offset)
+		}
+		return nil
+	})
+}
+
+// regexpErrorOffset returns the byte offset of the error in the expression, as syntax.Error has no position.
+// The parser reads from left to right, so the shortest prefix that fails with the same error ends where
+// the error is detected, and the offending part, if any, ends there.
+func regexpErrorOffset(expr string, err *syntax.Error) int {
+	if err.Code == syntax.ErrMissingParen {
+		return len(expr)
+	}
+
+	n := sort.Search(len(expr)+1, func(n int) bool {
+		var prefixErr *syntax.Error
+		_, perr := syntax.Parse(expr[:n], syntax.Perl)
+		return errors.As(perr, &prefixErr) && prefixErr.Code == err.Code &&
+			(prefixErr.Expr == err.Expr || err.Expr == expr && prefixErr.Expr == expr[:n])
+	})
+
+	switch {
+	case n > len(expr):
+		return 0
+	case err.Expr != "" && err.Expr != expr && strings.HasSuffix(expr[:n], err.Expr):
+		return n - len(err.Expr)
+	case n > 0:
+		return n - 1
+	default:
+		return 0
+	}
+}
+
+// templateErrorPosition extracts the line and the reason from a parse error of text/template,
+// which is formatted as "template: <name>:<line>: <reason>".
+func templateErrorPosition(msg string) (int, string) {
+	rest := strings.TrimPrefix(msg, "template: :")
+	digits, reason, ok := strings.Cut(rest, ": ")
+	line, err := strconv.Atoi(digits)
+	if !ok || err != nil {
+		return 0, msg
+	}
+	return line, reason
+}
+
+// invalidUTF8Offset returns the byte offset of the first invalid UTF-8 sequence.
+func invalidUTF8Offset(s string) int {
+	for i, r := range s {
+		if r == utf8.RuneError {
+			if _, size := utf8.DecodeRuneInString(s[i:]); size == 1 {
+				return i
+			}
+		}
+	}
+	return len(s)
+}
+
+// globErrorOffset returns the byte offset of the syntax error in the path.Match pattern, or -1 if it is valid.
+func globErrorOffset(pattern string) int {
+	for i := 0; i < len(pattern); {
+		switch pattern[i] {
+		case '\\':
+			if i+1 == len(pattern) {
+				return i
+			}
+			i += 2
+		case '[':
+			end := globClassEnd(pattern, i)
+			if end < 0 {
+				return i
+			}
+			i = end
+		default:
+			i++
+		}
+	}
+	return -1
+}
+
+// globClassEnd returns the offset after the character class starting at the offset, or -1 if it is malformed.
+func globClassEnd(pattern string, start int) int {
+	i := start + 1
+	if i < len(pattern) && pattern[i] == '^' {
+		i++
+	}
+
+	for first := true; ; first = false {
+		if i >= len(pattern) {
+			return -1
+		}
+		if pattern[i] == ']' && !first {
+			return i + 1
+		}
+
+		n := globCharLen(pattern[i:])
+		if n < 0 {
+			return -1
+		}
+		i += n
+
+		if i < len(pattern) && pattern[i] == '-' {
+			n = globCharLen(pattern[i+1:])
+			if n < 0 {
+				return -1
+			}
+			i += 1 + n
+		}
+	}
+}
+
+// globCharLen returns the length of the possibly escaped character of a character class, as read by path.Match,
+// or -1 if it is malformed.
+func globCharLen(s string) int {
+	if s == "" || s[0] == '-' || s[0] == ']' {
+		return -1
+	}
+
+	n := 0
+	if s[0] == '\\' {
+		n, s = 1, s[1:]
+		if s == "" {
+			return -1
+		}
+	}
+
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError && size == 1 {
+		return -1
+	}
+	return n + size
+}
@@ -0,0 +1,89 @@
+package goval_test
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/pkg-id/goval"
+)
+
+func TestStringValidator_ValidRegexp(t *testing.T) {
+	validator := goval.String().ValidRegexp(0)
+	bounded := goval.String().ValidRegexp(100)
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "valid", validator: validator, input: `^[a-z]+\d{2,3}$`},
+		{desc: "empty", validator: validator, input: ""},
+		{desc: "missing paren", validator: validator, input: "ab(c", code: goval.StringRegexp, args: []any{4, "missing closing )"}},
+		{desc: "unexpected paren", validator: validator, input: "a)b", code: goval.StringRegexp, args: []any{1, "unexpected )"}},
+		{desc: "missing bracket", validator: validator, input: "x[ab", code: goval.StringRegexp, args: []any{1, "missing closing ]"}},
+		{desc: "trailing backslash", validator: validator, input: `ab\`, code: goval.StringRegexp, args: []any{2, "trailing backslash at end of expression"}},
+		{desc: "missing repeat argument", validator: validator, input: "*a", code: goval.StringRegexp, args: []any{0, "missing argument to repetition operator"}},
+		{desc: "bad escape", validator: validator, input: `abc\q`, code: goval.StringRegexp, args: []any{3, "invalid escape sequence"}},
+		{desc: "bad repetition", validator: validator, input: "a**", code: goval.StringRegexp, args: []any{1, "invalid nested repetition operator"}},
+		{desc: "bad class range", validator: validator, input: "x[z-a]", code: goval.StringRegexp, args: []any{2, "invalid character class range"}},
+		{desc: "escaped range before", validator: validator, input: `\[z-a\]x[z-a]`, code: goval.StringRegexp, args: []any{9, "invalid character class range"}},
+		{desc: "lookahead", validator: validator, input: "a(?=b)", code: goval.StringRegexp, args: []any{1, "invalid or unsupported Perl syntax"}},
+		{desc: "within size", validator: bounded, input: "[a-z]{3}"},
+		{desc: "too large", validator: bounded, input: "(a{30}){30}", code: goval.StringRegexpSize, args: []any{100}},
+	})
+}
+
+func TestStringValidator_ValidTemplate(t *testing.T) {
+	validator := goval.String().ValidTemplate("upper", "date")
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "text", validator: validator, input: "Hello"},
+		{desc: "field", validator: validator, input: "Hello {{.Name}}"},
+		{desc: "allowed function", validator: validator, input: "Hello {{upper .Name}} at {{date .Now}}"},
+		{desc: "builtin function", validator: validator, input: `{{if gt (len .Items) 0}}{{printf "%d" (len .Items)}}{{end}}`},
+		{desc: "unknown function", validator: validator, input: "a\n{{exec .Cmd}}", code: goval.StringTemplate, args: []any{2, `function "exec" not defined`}},
+		{desc: "unclosed action", validator: validator, input: "Hello {{.Name", code: goval.StringTemplate, args: []any{1, "unclosed action"}},
+		{desc: "missing end", validator: validator, input: "{{if .A}}\nx", code: goval.StringTemplate, args: []any{2, "unexpected EOF"}},
+	})
+}
+
+func TestStringValidator_JSONPointer(t *testing.T) {
+	validator := goval.String().JSONPointer()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "whole document", validator: validator, input: ""},
+		{desc: "root key", validator: validator, input: "/"},
+		{desc: "path", validator: validator, input: "/items/0/name"},
+		{desc: "escapes", validator: validator, input: "/a~1b/m~0n"},
+		{desc: "unicode", validator: validator, input: "/ñame"},
+		{desc: "no leading slash", validator: validator, input: "items/0", code: goval.StringJSONPointer, args: []any{0}},
+		{desc: "fragment form", validator: validator, input: "#/items", code: goval.StringJSONPointer, args: []any{0}},
+		{desc: "bad escape", validator: validator, input: "/a~2b", code: goval.StringJSONPointer, args: []any{2}},
+		{desc: "trailing tilde", validator: validator, input: "/a/b~", code: goval.StringJSONPointer, args: []any{4}},
+		{desc: "invalid utf-8", validator: validator, input: "/a\xffb", code: goval.StringJSONPointer, args: []any{2}},
+	})
+}
+
+func TestStringValidator_GlobPattern(t *testing.T) {
+	validator := goval.String().GlobPattern()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "star", validator: validator, input: "*.go"},
+		{desc: "class", validator: validator, input: "img/[a-c]?.png"},
+		{desc: "negated class", validator: validator, input: "[^0-9]*"},
+		{desc: "escaped", validator: validator, input: `\*.txt`},
+		{desc: "escaped in class", validator: validator, input: `[\]\-]`},
+		{desc: "unclosed class", validator: validator, input: "a[bc", code: goval.StringGlob, args: []any{1}},
+		{desc: "empty class", validator: validator, input: "x[]", code: goval.StringGlob, args: []any{1}},
+		{desc: "dangling range", validator: validator, input: "[a-]", code: goval.StringGlob, args: []any{0}},
+		{desc: "trailing backslash", validator: validator, input: `abc\`, code: goval.StringGlob, args: []any{3}},
+	})
+}
+
+func TestStringValidator_GlobPatternMatchesPathMatch(t *testing.T) {
+	patterns := []string{
+		"", "*", "a?c", "[abc]", "[^abc]", "[a-z0-9]", "[", "]", "[]", "[]]", "[^]", "[a-", "[a-]", "[-a]", "[\\",
+		"[\\]]", "\\", "\\\\", "a\\b", "[\\-a]", "[a\\-z]", "[ñ-ü]", "[\xff]", "\xff*", "[a-b-c]", "[[]", "*[*", "[!a]",
+	}
+
+	validator := goval.String().GlobPattern()
+	for _, p := range patterns {
+		_, err := path.Match(p, "")
+		if got := validator.Validate(context.Background(), p) == nil; got != (err == nil) {
+			t.Errorf("%q: expect valid %v like path.Match; got %v", p, err == nil, got)
+		}
+	}
+}
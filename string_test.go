@@ -87,6 +87,90 @@ func TestStringValidator_Max(t *testing.T) {
 	}
 }
 
+// stringRuleTest is a test case of a string rule, the code is nil if the input is valid.
+type stringRuleTest struct {
+	desc      string
+	validator goval.StringValidator
+	input     string
+	code      goval.RuleCoder
+	args      []any
+}
+
+// runStringRuleTests validates each input by its validator and checks the code and the args of the error.
+func runStringRuleTests(t *testing.T, tests []stringRuleTest) {
+	t.Helper()
+	ctx := context.Background()
+	for _, tc := range tests {
+		err := tc.validator.Validate(ctx, tc.input)
+		if tc.code == nil {
+			if err != nil {
+				t.Errorf("%s: expect no error; got error: %v", tc.desc, err)
+			}
+			continue
+		}
+
+		var exp *goval.RuleError
+		if !errors.As(err, &exp) {
+			t.Errorf("%s: expect error type: %T; got error type: %T", tc.desc, exp, err)
+			continue
+		}
+
+		if !exp.Code.Equal(tc.code) {
+			t.Errorf("%s: expect the error code: %v; got error code: %v", tc.desc, tc.code, exp.Code)
+		}
+
+		if !reflect.DeepEqual(exp.Args, tc.args) {
+			t.Errorf("%s: expect the error args: %v; got error args: %v", tc.desc, tc.args, exp.Args)
+		}
+	}
+}
+
+func TestStringValidator_UnicodeLength(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		desc      string
+		validator goval.StringValidator
+		input     string
+		code      goval.RuleCoder
+		args      []any
+	}{
+		{desc: "rune min ok", validator: goval.String().RuneMin(4), input: "Désï"},
+		{desc: "rune min fails", validator: goval.String().RuneMin(5), input: "Désï", code: goval.StringRuneMin, args: []any{5}},
+		{desc: "rune max ok", validator: goval.String().RuneMax(4), input: "Désï"},
+		{desc: "rune max fails", validator: goval.String().RuneMax(3), input: "Désï", code: goval.StringRuneMax, args: []any{3}},
+		{desc: "grapheme min ok", validator: goval.String().GraphemeMin(3), input: "👍🏽🇮🇩😀"},
+		{desc: "grapheme min fails", validator: goval.String().GraphemeMin(4), input: "👍🏽🇮🇩😀", code: goval.StringGraphemeMin, args: []any{4}},
+		{desc: "grapheme max ok", validator: goval.String().GraphemeMax(3), input: "👍🏽🇮🇩😀"},
+		{desc: "grapheme max fails", validator: goval.String().GraphemeMax(2), input: "👍🏽🇮🇩😀", code: goval.StringGraphemeMax, args: []any{2}},
+		{desc: "grapheme max counts combining marks", validator: goval.String().GraphemeMax(4), input: "De\u0301si\u0308"},
+		{desc: "display width ok", validator: goval.String().DisplayWidthMax(6), input: "日本語"},
+		{desc: "display width fails", validator: goval.String().DisplayWidthMax(5), input: "日本語", code: goval.StringDisplayWidthMax, args: []any{5}},
+	}
+
+	for _, tc := range tests {
+		err := tc.validator.Validate(ctx, tc.input)
+		if tc.code == nil {
+			if err != nil {
+				t.Errorf("%s: expect no error; got error: %v", tc.desc, err)
+			}
+			continue
+		}
+
+		var exp *goval.RuleError
+		if !errors.As(err, &exp) {
+			t.Fatalf("%s: expect error type: %T; got error type: %T", tc.desc, exp, err)
+		}
+
+		if !exp.Code.Equal(tc.code) {
+			t.Errorf("%s: expect the error code: %v; got error code: %v", tc.desc, tc.code, exp.Code)
+		}
+
+		if !reflect.DeepEqual(exp.Args, tc.args) {
+			t.Errorf("%s: expect the error args: %v; got error args: %v", tc.desc, tc.args, exp.Args)
+		}
+	}
+}
+
 func TestStringValidator_Match(t *testing.T) {
 	ctx := context.Background()
 	err := goval.String().Match(govalregex.AlphaNumeric).Validate(ctx, "abc123")
@@ -117,7 +201,12 @@ func TestStringValidator_Match(t *testing.T) {
 		t.Errorf("expect panic error; got nil")
 	}
 
-	if !strings.HasPrefix(err.Error(), "panic") {
+	var ie *goval.InternalError
+	if !errors.As(err, &ie) {
+		t.Fatalf("expect error type: %T; got error type: %T", ie, err)
+	}
+
+	if !strings.HasPrefix(ie.Err.Error(), "panic") {
 		t.Errorf("expect error panic; got %v", err)
 	}
 }
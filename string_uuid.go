@@ -0,0 +1,210 @@
+package goval
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/pkg-id/goval/funcs"
+)
+
+// UUID ensures that the string is a UUID in the canonical form, e.g. "f81d4fae-7dec-11d0-a765-00a0c91e6bf6",
+// of one of the given versions, e.g. 4 and 7, or of any version if none is given. The hex digits are
+// case-insensitive, and the nil UUID "00000000-0000-0000-0000-000000000000" is accepted regardless of the versions,
+// chain UUIDNotNil, UUIDVariant and UUIDLowercase to tighten it.
+//
+//	goval.String().UUID(4, 7).UUIDNotNil().UUIDVariant().UUIDLowercase()
+//
+// It returns StringUUID if the string is malformed, and StringUUIDVersion with the version and the allowed versions
+// if the version is not allowed.
+func (f SVV[T]) UUID(versions ...int) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		uuid, ok := parseUUID(string(value))
+		if !ok {
+			return NewRuleError(StringUUID)
+		}
+		return checkUUIDVersion(uuid, versions)
+	})
+}
+
+// UUIDNotNil ensures that the string is a UUID that is not the nil UUID "00000000-0000-0000-0000-000000000000".
+// It returns StringUUID if the string is malformed and StringUUIDNil for the nil UUID.
+func (f SVV[T]) UUIDNotNil() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		uuid, ok := parseUUID(string(value))
+		if !ok {
+			return NewRuleError(StringUUID)
+		}
+		return checkUUIDNotNil(uuid)
+	})
+}
+
+// UUIDVariant ensures that the string is a UUID with the variant of RFC 9562, the one used by the versions 1 to 8,
+// that is, the 17th hex digit is one of 8, 9, a or b. The nil UUID is accepted, see UUIDNotNil.
+// It returns StringUUID if the string is malformed and StringUUIDVariant if the variant is another one.
+func (f SVV[T]) UUIDVariant() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		uuid, ok := parseUUID(string(value))
+		if !ok {
+			return NewRuleError(StringUUID)
+		}
+		return checkUUIDVariant(uuid)
+	})
+}
+
+// UUIDLowercase ensures that the string is a UUID with the hex digits in lowercase, as UUIDs are written by RFC 9562.
+// It returns StringUUID if the string is malformed and StringUUIDCase if it is not in lowercase.
+func (f SVV[T]) UUIDLowercase() SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		if _, ok := parseUUID(string(value)); !ok {
+			return NewRuleError(StringUUID)
+		}
+
+		if strings.ToLower(string(value)) != string(value) {
+			return NewRuleError(StringUUIDCase)
+		}
+		return nil
+	})
+}
+
+// UUIDv7 ensures that the string is a UUID of version 7 with the variant of RFC 9562, then validates
+// its embedded Unix timestamp with the given validator, e.g. to check that it lies in a sane window.
+// The validator may be nil to only check the UUID.
+//
+//	goval.String().UUIDv7(goval.Time().Min(launch).Max(time.Now().Add(time.Minute)))
+//
+// Besides the errors of UUID, UUIDNotNil and UUIDVariant, the errors of the validator are returned as is.
+func (f SVV[T]) UUIDv7(timestamp TimeValidator) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		uuid, ok := parseUUID(string(value))
+		if !ok {
+			return NewRuleError(StringUUID)
+		}
+
+		if err := checkUUIDNotNil(uuid); err != nil {
+			return err
+		}
+
+		if err := checkUUIDVersion(uuid, []int{7}); err != nil {
+			return err
+		}
+
+		if err := checkUUIDVariant(uuid); err != nil {
+			return err
+		}
+		return validateTimestamp(ctx, timestamp, uuid[:6])
+	})
+}
+
+// ULID ensures that the string is a ULID, 26 characters of Crockford's base32, case-insensitively,
+// e.g. "01ARZ3NDEKTSV4RRFFQ69G5FAV", then validates its embedded Unix timestamp with the given validator.
+// The validator may be nil to only check the ULID.
+//
+// It returns StringULID if the string is malformed, the errors of the validator are returned as is.
+func (f SVV[T]) ULID(timestamp TimeValidator) SVV[T] {
+	return f.With(func(ctx context.Context, value T) error {
+		ulid, ok := parseULID(string(value))
+		if !ok {
+			return NewRuleError(StringULID)
+		}
+		return validateTimestamp(ctx, timestamp, ulid[:6])
+	})
+}
+
+// parseUUID decodes the UUID in the canonical form into its 16 bytes.
+func parseUUID(value string) ([16]byte, bool) {
+	var uuid [16]byte
+	if len(value) != 36 || value[8] != '-' || value[13] != '-' || value[18] != '-' || value[23] != '-' {
+		return uuid, false
+	}
+
+	digits := value[0:8] + value[9:13] + value[14:18] + value[19:23] + value[24:]
+	if _, err := hex.Decode(uuid[:], []byte(digits)); err != nil {
+		return uuid, false
+	}
+	return uuid, true
+}
+
+// checkUUIDVersion checks that the version of the UUID is one of the versions, if any, the nil UUID has no version.
+func checkUUIDVersion(uuid [16]byte, versions []int) error {
+	if uuid == [16]byte{} || len(versions) == 0 {
+		return nil
+	}
+
+	version := int(uuid[6] >> 4)
+	if !funcs.Contains(versions, func(v int) bool { return v == version }) {
+		return NewRuleError(StringUUIDVersion, version, versions)
+	}
+	return nil
+}
+
+// checkUUIDNotNil checks that the UUID is not the nil UUID.
+func checkUUIDNotNil(uuid [16]byte) error {
+	if uuid == [16]byte{} {
+		return NewRuleError(StringUUIDNil)
+	}
+	return nil
+}
+
+// checkUUIDVariant checks that the UUID has the variant of RFC 9562, the nil UUID has no variant.
+func checkUUIDVariant(uuid [16]byte) error {
+	if uuid != [16]byte{} && uuid[8]&0xc0 != 0x80 {
+		return NewRuleError(StringUUIDVariant)
+	}
+	return nil
+}
+
+// crockfordBase32 is the alphabet of ULID, it excludes I, L, O and U.
+const crockfordBase32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// parseULID decodes the ULID into its 16 bytes, the first 6 bytes are the timestamp.
+func parseULID(value string) ([16]byte, bool) {
+	var ulid [16]byte
+	// 26 characters encode 130 bits, so the first character must not exceed 7 to fit in 128 bits.
+	if len(value) != 26 || value[0] > '7' {
+		return ulid, false
+	}
+
+	var acc uint64
+	bits, n := 0, 0
+	for i := 0; i < len(value); i++ {
+		v := strings.IndexByte(crockfordBase32, upperASCII(value[i]))
+		if v < 0 {
+			return ulid, false
+		}
+
+		acc = acc<<5 | uint64(v)
+		bits += 5
+		if i == 0 {
+			bits -= 2 // the 2 padding bits of the first character.
+		}
+		for bits >= 8 {
+			bits -= 8
+			ulid[n] = byte(acc >> uint(bits))
+			n++
+		}
+	}
+	return ulid, true
+}
+
+// validateTimestamp validates the 48-bit big-endian Unix timestamp in milliseconds with the validator, if any.
+func validateTimestamp(ctx context.Context, validator TimeValidator, b []byte) error {
+	if validator == nil {
+		return nil
+	}
+
+	var buf [8]byte
+	copy(buf[2:], b)
+	ms := int64(binary.BigEndian.Uint64(buf[:]))
+	return validator.Validate(ctx, time.UnixMilli(ms).UTC())
+}
+
+// upperASCII returns the uppercase of an ASCII letter, other bytes are returned as is.
+func upperASCII(c byte) byte {
+	if 'a' <= c && c <= 'z' {
+		return c - 'a' + 'A'
+	}
+	return c
+}
@@ -0,0 +1,75 @@
+package goval_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg-id/goval"
+)
+
+func TestStringValidator_UUID(t *testing.T) {
+	anyUUID := goval.String().UUID()
+	strict := goval.String().UUID(4, 7).UUIDNotNil().UUIDVariant().UUIDLowercase()
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "v1", validator: anyUUID, input: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+		{desc: "uppercase", validator: anyUUID, input: "F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6"},
+		{desc: "nil", validator: anyUUID, input: "00000000-0000-0000-0000-000000000000"},
+		{desc: "no hyphens", validator: anyUUID, input: "f81d4fae7dec11d0a76500a0c91e6bf6", code: goval.StringUUID},
+		{desc: "braces", validator: anyUUID, input: "{f81d4fae-7dec-11d0-a765-00a0c91e6bf6}", code: goval.StringUUID},
+		{desc: "misplaced hyphen", validator: anyUUID, input: "f81d4fae7-dec-11d0-a765-00a0c91e6bf6", code: goval.StringUUID},
+		{desc: "not hex", validator: anyUUID, input: "g81d4fae-7dec-11d0-a765-00a0c91e6bf6", code: goval.StringUUID},
+		{desc: "empty", validator: anyUUID, input: "", code: goval.StringUUID},
+		{desc: "v4", validator: strict, input: "9b2e6a8e-4f3c-4d2a-8b1e-3c5d7e9f1a2b"},
+		{desc: "v7", validator: strict, input: "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"},
+		{desc: "strict uppercase", validator: strict, input: "017F22E2-79B0-7CC3-98C4-DC0C0C07398F", code: goval.StringUUIDCase},
+		{desc: "strict nil", validator: strict, input: "00000000-0000-0000-0000-000000000000", code: goval.StringUUIDNil},
+		{desc: "strict v1", validator: strict, input: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", code: goval.StringUUIDVersion, args: []any{1, []int{4, 7}}},
+		{desc: "strict max", validator: strict, input: "ffffffff-ffff-ffff-ffff-ffffffffffff", code: goval.StringUUIDVersion, args: []any{15, []int{4, 7}}},
+		{desc: "strict microsoft variant", validator: strict, input: "9b2e6a8e-4f3c-4d2a-cb1e-3c5d7e9f1a2b", code: goval.StringUUIDVariant},
+		{desc: "strict ncs variant", validator: strict, input: "9b2e6a8e-4f3c-4d2a-7b1e-3c5d7e9f1a2b", code: goval.StringUUIDVariant},
+		{desc: "nil ignores versions", validator: goval.String().UUID(4), input: "00000000-0000-0000-0000-000000000000"},
+		{desc: "nil ignores variant", validator: goval.String().UUIDVariant(), input: "00000000-0000-0000-0000-000000000000"},
+		{desc: "not nil malformed", validator: goval.String().UUIDNotNil(), input: "not-a-uuid", code: goval.StringUUID},
+		{desc: "variant malformed", validator: goval.String().UUIDVariant(), input: "not-a-uuid", code: goval.StringUUID},
+		{desc: "lowercase malformed", validator: goval.String().UUIDLowercase(), input: "NOT-A-UUID", code: goval.StringUUID},
+	})
+}
+
+func TestStringValidator_UUIDv7(t *testing.T) {
+	// 017f22e2-79b0 is 2022-02-22T19:22:22Z, the example of RFC 9562.
+	example := "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "without window", validator: goval.String().UUIDv7(nil), input: example},
+		{desc: "in window", validator: goval.String().UUIDv7(goval.Time().Min(until).Max(since)), input: example},
+		{desc: "too old", validator: goval.String().UUIDv7(goval.Time().Min(since)), input: example, code: goval.TimeMin, args: []any{since}},
+		{desc: "in the future", validator: goval.String().UUIDv7(goval.Time().Max(until)), input: example, code: goval.TimeMax, args: []any{until}},
+		{
+			desc:      "v4",
+			validator: goval.String().UUIDv7(nil),
+			input:     "9b2e6a8e-4f3c-4d2a-8b1e-3c5d7e9f1a2b",
+			code:      goval.StringUUIDVersion,
+			args:      []any{4, []int{7}},
+		},
+		{desc: "nil", validator: goval.String().UUIDv7(nil), input: "00000000-0000-0000-0000-000000000000", code: goval.StringUUIDNil},
+		{desc: "variant", validator: goval.String().UUIDv7(nil), input: "017f22e2-79b0-7cc3-e8c4-dc0c0c07398f", code: goval.StringUUIDVariant},
+	})
+}
+
+func TestStringValidator_ULID(t *testing.T) {
+	// 01ARZ3NDEK is 2016-07-30T23:54:10.259Z.
+	example := "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+	stamp := time.Date(2016, 7, 30, 23, 54, 10, 259*int(time.Millisecond), time.UTC)
+	runStringRuleTests(t, []stringRuleTest{
+		{desc: "ulid", validator: goval.String().ULID(nil), input: example},
+		{desc: "lowercase", validator: goval.String().ULID(nil), input: "01arz3ndektsv4rrffq69g5fav"},
+		{desc: "max", validator: goval.String().ULID(nil), input: "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"},
+		{desc: "overflow", validator: goval.String().ULID(nil), input: "8ZZZZZZZZZZZZZZZZZZZZZZZZZ", code: goval.StringULID},
+		{desc: "excluded letter", validator: goval.String().ULID(nil), input: "01ARZ3NDEKTSV4RRFFQ69G5FAU", code: goval.StringULID},
+		{desc: "too short", validator: goval.String().ULID(nil), input: "01ARZ3NDEKTSV4RRFFQ69G5FA", code: goval.StringULID},
+		{desc: "exact time", validator: goval.String().ULID(goval.Time().Min(stamp).Max(stamp)), input: example},
+		{desc: "before", validator: goval.String().ULID(goval.Time().Min(stamp.Add(time.Millisecond))), input: example, code: goval.TimeMin, args: []any{stamp.Add(time.Millisecond)}},
+		{desc: "after", validator: goval.String().ULID(goval.Time().Max(stamp.Add(-time.Millisecond))), input: example, code: goval.TimeMax, args: []any{stamp.Add(-time.Millisecond)}},
+	})
+}